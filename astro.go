@@ -0,0 +1,305 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"math"
+	"time"
+)
+
+// This file holds the astronomical computations needed by the lunar and lunisolar
+// calendars: times of new moons, and positions of the Sun and the Moon. They follow
+// "Astronomical Algorithms" by Jean Meeus (2nd edition), keeping the main terms only,
+// which is accurate to a few minutes for new moons and to about 0.01° for the Sun.
+
+// jdUnixEpoch is the Julian day of the Unix epoch (1970-01-01 00:00 UTC).
+const jdUnixEpoch = 2440587.5
+
+// julianDay returns the Julian day of t.
+func julianDay(t time.Time) float64 {
+	return jdUnixEpoch + float64(t.Unix())/86400 + float64(t.Nanosecond())/86400e9
+}
+
+// timeFromJulianDay returns the time of Julian day jd, in UTC.
+func timeFromJulianDay(jd float64) time.Time {
+	sec := (jd - jdUnixEpoch) * 86400
+	return time.Unix(int64(math.Floor(sec)), 0).UTC()
+}
+
+// dayNumber returns the Julian day number of the civil date y-m-d (the Julian day at
+// noon of that date).
+func dayNumber(y int, m time.Month, d int) int {
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400) + 2440588
+}
+
+// dateFromDayNumber returns the Gregorian date of Julian day number jdn.
+func dateFromDayNumber(jdn int) (int, time.Month, int) {
+	return time.Unix(int64(jdn-2440588)*86400, 0).UTC().Date()
+}
+
+// deltaT returns the difference between Terrestrial Time and Universal Time in days,
+// at Julian day jd, using the polynomial expressions of Espenak and Meeus.
+func deltaT(jd float64) float64 {
+	y := 2000 + (jd-2451544.5)/365.25
+	var s float64
+	switch {
+	case y < 1800:
+		u := (y - 1820) / 100
+		s = -20 + 32*u*u
+	case y < 1860:
+		t := y - 1800
+		s = 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*t*t*t*t + 0.0000121272*t*t*t*t*t - 0.0000001699*t*t*t*t*t*t + 0.000000000875*t*t*t*t*t*t*t
+	case y < 1900:
+		t := y - 1860
+		s = 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*t*t*t*t + t*t*t*t*t/233174
+	case y < 1920:
+		t := y - 1900
+		s = -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		s = 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		s = 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		s = 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		s = 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		s = 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		s = -20 + 32*((y-1820)/100)*((y-1820)/100) - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		s = -20 + 32*u*u
+	}
+	return s / 86400
+}
+
+// sind, cosd and normDeg work with angles in degrees.
+func sind(x float64) float64 { return math.Sin(x * math.Pi / 180) }
+func cosd(x float64) float64 { return math.Cos(x * math.Pi / 180) }
+func normDeg(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	return x
+}
+
+// newMoonK returns the lunation number of the first new moon after Julian day jd, the
+// new moon of January 6th 2000 being lunation 0.
+func newMoonK(jd float64) int {
+	k := int(math.Floor((jd - 2451550.09766) / 29.530588861))
+	for newMoon(k) < jd {
+		k++
+	}
+	for newMoon(k-1) >= jd {
+		k--
+	}
+	return k
+}
+
+// newMoon returns the Julian day (in Universal Time) of new moon of lunation k
+// (Meeus, chapter 49).
+func newMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+
+	jde := 2451550.09766 + 29.530588861*kf + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := 2.5534 + 29.10535670*kf - 0.0000014*t2 - 0.00000011*t3
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4
+	f := 160.7108 + 390.67050284*kf - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4
+	om := 124.7746 - 1.56375588*kf + 0.0020672*t2 + 0.00000215*t3
+
+	jde += -0.40720*sind(mp) +
+		0.17241*e*sind(m) +
+		0.01608*sind(2*mp) +
+		0.01039*sind(2*f) +
+		0.00739*e*sind(mp-m) -
+		0.00514*e*sind(mp+m) +
+		0.00208*e*e*sind(2*m) -
+		0.00111*sind(mp-2*f) -
+		0.00057*sind(mp+2*f) +
+		0.00056*e*sind(2*mp+m) -
+		0.00042*sind(3*mp) +
+		0.00042*e*sind(m+2*f) +
+		0.00038*e*sind(m-2*f) -
+		0.00024*e*sind(2*mp-m) -
+		0.00017*sind(om) -
+		0.00007*sind(mp+2*m) +
+		0.00004*sind(2*mp-2*f) +
+		0.00004*sind(3*m) +
+		0.00003*sind(mp+m-2*f) +
+		0.00003*sind(2*mp+2*f) -
+		0.00003*sind(mp+m+2*f) +
+		0.00003*sind(mp-m+2*f) -
+		0.00002*sind(mp-m-2*f) -
+		0.00002*sind(3*mp+m) +
+		0.00002*sind(4*mp)
+
+	// planetary arguments
+	for _, a := range [...]struct{ c, a, b float64 }{
+		{0.000325, 299.77, 0.107408}, {0.000165, 251.88, 0.016321}, {0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478}, {0.000110, 84.66, 18.206239}, {0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732}, {0.000056, 154.84, 7.306860}, {0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824}, {0.000040, 291.34, 1.844379}, {0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099}, {0.000023, 331.55, 3.592518},
+	} {
+		arg := a.a + a.b*kf
+		if a.a == 299.77 {
+			arg -= 0.009173 * t2
+		}
+		jde += a.c * sind(arg)
+	}
+	return jde - deltaT(jde)
+}
+
+// sunLongitude returns the apparent ecliptic longitude of the Sun in degrees at Julian
+// day jd, in Universal Time (Meeus, chapter 25, low accuracy).
+func sunLongitude(jd float64) float64 {
+	t := (jd + deltaT(jd) - 2451545) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	c := (1.914602-0.004817*t-0.000014*t*t)*sind(m) + (0.019993-0.000101*t)*sind(2*m) + 0.000289*sind(3*m)
+	om := 125.04 - 1934.136*t
+	return normDeg(l0 + c - 0.00569 - 0.00478*sind(om))
+}
+
+// solarLongitudeAfter returns the Julian day (in Universal Time) at which the apparent
+// longitude of the Sun reaches lon degrees, for the first time after Julian day jd.
+func solarLongitudeAfter(jd, lon float64) float64 {
+	// the Sun moves by about 360° in 365.2422 days
+	d := normDeg(lon - sunLongitude(jd))
+	x := jd + d*365.2422/360
+	for i := 0; i < 5; i++ {
+		diff := math.Mod(lon-sunLongitude(x)+540, 360) - 180
+		x += diff * 365.2422 / 360
+	}
+	return x
+}
+
+// obliquity returns the mean obliquity of the ecliptic in degrees, for T in Julian
+// centuries from J2000.
+func obliquity(t float64) float64 {
+	return 23.439291 - 0.0130042*t
+}
+
+// equatorial converts ecliptic coordinates (in degrees) to right ascension and
+// declination (in degrees).
+func equatorial(lon, lat, eps float64) (ra, dec float64) {
+	ra = math.Atan2(sind(lon)*cosd(eps)-math.Tan(lat*math.Pi/180)*sind(eps), cosd(lon)) * 180 / math.Pi
+	dec = math.Asin(sind(lat)*cosd(eps)+cosd(lat)*sind(eps)*sind(lon)) * 180 / math.Pi
+	return normDeg(ra), dec
+}
+
+// moonPosition returns the geocentric ecliptic longitude and latitude of the Moon in
+// degrees, and its equatorial horizontal parallax in degrees, at Julian day jd in
+// Universal Time (Meeus, chapter 47, main terms).
+func moonPosition(jd float64) (lon, lat, parallax float64) {
+	t := (jd + deltaT(jd) - 2451545) / 36525
+	lp := 218.3164477 + 481267.88123421*t - 0.0015786*t*t
+	d := 297.8501921 + 445267.1114034*t - 0.0018819*t*t
+	m := 357.5291092 + 35999.0502909*t - 0.0001536*t*t
+	mp := 134.9633964 + 477198.8675055*t + 0.0087414*t*t
+	f := 93.2720950 + 483202.0175233*t - 0.0036539*t*t
+	a1 := 119.75 + 131.849*t
+	a2 := 53.09 + 479264.290*t
+	a3 := 313.45 + 481266.484*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+
+	// periodic terms: multiples of D, M, M' and F, with coefficients for the longitude
+	// and the distance (in 1e-6 degrees and meters)
+	var sl, sr float64
+	for _, x := range [...]struct{ d, m, mp, f, l, r float64 }{
+		{0, 0, 1, 0, 6288774, -20905355}, {2, 0, -1, 0, 1274027, -3699111}, {2, 0, 0, 0, 658314, -2955968},
+		{0, 0, 2, 0, 213618, -569925}, {0, 1, 0, 0, -185116, 48888}, {0, 0, 0, 2, -114332, -3149},
+		{2, 0, -2, 0, 58793, 246158}, {2, -1, -1, 0, 57066, -152138}, {2, 0, 1, 0, 53322, -170733},
+		{2, -1, 0, 0, 45758, -204586}, {0, 1, -1, 0, -40923, -129620}, {1, 0, 0, 0, -34720, 108743},
+		{0, 1, 1, 0, -30383, 104755}, {2, 0, 0, -2, 15327, 10321}, {0, 0, 1, 2, -12528, 0},
+		{0, 0, 1, -2, 10980, 79661}, {4, 0, -1, 0, 10675, -34782}, {0, 0, 3, 0, 10034, -23210},
+		{4, 0, -2, 0, 8548, -21636}, {2, 1, -1, 0, -7888, 24208}, {2, 1, 0, 0, -6766, 30824},
+		{1, 0, -1, 0, -5163, -8379}, {1, 1, 0, 0, 4987, -16675}, {2, -1, 1, 0, 4036, -12831},
+		{2, 0, 2, 0, 3994, -10445}, {4, 0, 0, 0, 3861, -11650}, {2, 0, -3, 0, 3665, 14403},
+	} {
+		arg := x.d*d + x.m*m + x.mp*mp + x.f*f
+		c := 1.0
+		if x.m != 0 {
+			c = math.Pow(e, math.Abs(x.m))
+		}
+		sl += c * x.l * sind(arg)
+		sr += c * x.r * cosd(arg)
+	}
+	var sb float64
+	for _, x := range [...]struct{ d, m, mp, f, b float64 }{
+		{0, 0, 0, 1, 5128122}, {0, 0, 1, 1, 280602}, {0, 0, 1, -1, 277693}, {2, 0, 0, -1, 173237},
+		{2, 0, -1, 1, 55413}, {2, 0, -1, -1, 46271}, {2, 0, 0, 1, 32573}, {0, 0, 2, 1, 17198},
+		{2, 0, 1, -1, 9266}, {0, 0, 2, -1, 8822}, {2, -1, 0, -1, 8216}, {2, 0, -2, -1, 4324},
+		{2, 0, 1, 1, 4200},
+	} {
+		c := 1.0
+		if x.m != 0 {
+			c = e
+		}
+		sb += c * x.b * sind(x.d*d+x.m*m+x.mp*mp+x.f*f)
+	}
+	sl += 3958*sind(a1) + 1962*sind(lp-f) + 318*sind(a2)
+	sb += -2235*sind(lp) + 382*sind(a3) + 175*sind(a1-f) + 175*sind(a1+f) + 127*sind(lp-mp) - 115*sind(lp+mp)
+
+	dist := 385000.56 + sr/1000
+	return normDeg(lp + sl/1e6), sb / 1e6, math.Asin(6378.14/dist) * 180 / math.Pi
+}
+
+// siderealTime returns the Greenwich mean sidereal time in degrees at Julian day jd.
+func siderealTime(jd float64) float64 {
+	t := (jd - 2451545) / 36525
+	return normDeg(280.46061837 + 360.98564736629*(jd-2451545) + 0.000387933*t*t)
+}
+
+// altitude returns the altitude in degrees of a body at right ascension ra and
+// declination dec, seen at Julian day jd from latitude lat and longitude lon (east
+// positive).
+func altitude(jd, ra, dec, lat, lon float64) float64 {
+	h := siderealTime(jd) + lon - ra
+	return math.Asin(sind(lat)*sind(dec)+cosd(lat)*cosd(dec)*cosd(h)) * 180 / math.Pi
+}
+
+// sunPosition returns the right ascension and declination of the Sun in degrees at
+// Julian day jd.
+func sunPosition(jd float64) (ra, dec float64) {
+	t := (jd - 2451545) / 36525
+	return equatorial(sunLongitude(jd), 0, obliquity(t))
+}
+
+// sunset returns the Julian day of sunset seen from latitude lat and longitude lon, on
+// the day starting at Julian day day0 (local midnight), by refining the time at which
+// the Sun's altitude is -0.8333°.
+func sunset(day0, lat, lon float64) float64 {
+	jd := day0 + 0.75 // start from 18:00 local time
+	for i := 0; i < 5; i++ {
+		ra, dec := sunPosition(jd)
+		cosH := (sind(-0.8333) - sind(lat)*sind(dec)) / (cosd(lat) * cosd(dec))
+		h := math.Acos(math.Max(-1, math.Min(1, cosH))) * 180 / math.Pi
+		// the Sun crosses the meridian when the local sidereal time equals its right ascension
+		transit := normDeg(ra - siderealTime(jd) - lon)
+		if transit > 180 {
+			transit -= 360
+		}
+		jd += (transit + h) / 360.98564736629
+	}
+	return jd
+}
+
+// moonAboveHorizon reports whether the Moon has not set yet at Julian day jd, seen
+// from latitude lat and longitude lon, taking into account its parallax, semi-diameter
+// and atmospheric refraction.
+func moonAboveHorizon(jd, lat, lon float64) bool {
+	mlon, mlat, parallax := moonPosition(jd)
+	t := (jd - 2451545) / 36525
+	ra, dec := equatorial(mlon, mlat, obliquity(t))
+	return altitude(jd, ra, dec, lat, lon) > 0.7275*parallax-0.5667
+}
@@ -107,3 +107,11 @@ func BenchmarkFormatters(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkKarpelesLabCompiled(b *testing.B) {
+	var t time.Time
+	p := klbstrftime.MustCompile(benchfmt)
+	for i := 0; i < b.N; i++ {
+		p.Format(t)
+	}
+}
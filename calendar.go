@@ -0,0 +1,377 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// CalendarDate holds the fields of a date in a calendar system, as returned by
+// Calendar.Date.
+type CalendarDate struct {
+	Time      time.Time // Time the date was converted from
+	Era       int       // Era of the date, as defined by the calendar (0 for calendars with a single era)
+	Year      int       // Year within the era
+	Month     int       // Month of the year, from 1
+	Day       int       // Day of the month, from 1
+	LeapMonth bool      // Whether the month is a leap (intercalary) month
+}
+
+// Calendar converts times to dates of a calendar system other than the Gregorian one,
+// such as the Japanese or Buddhist calendars. It drives the conversions with the E
+// modifier: %EC, %Ey and %EY for the era and year, %Em, %Ed and %Ee for the month and
+// day, and %EB and %Eb for the month name, along with the era formats (%Ec, %Ex, %EX).
+//
+// Calendars are set in Locale.Calendar, selected with the ca Unicode extension of the
+// language tag or with Formatter.WithCalendar (see RegisterCalendar).
+type Calendar interface {
+	// Date converts t to a date of the calendar.
+	Date(t time.Time) CalendarDate
+
+	// AppendEra appends conversion %Ec of date d to b, in the language of tag, where c
+	// is 'C' (era name), 'y' (year in era), 'Y' (era and year), 'r' or 'k' (year with an
+	// abbreviated era name, which can be the same as 'y'), or any conversion specific to
+	// the calendar. ok is false, and b is returned unchanged, if the calendar does not
+	// support c.
+	AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) (res []byte, ok bool)
+
+	// MonthName returns the full or abbreviated name of the month of date d in the
+	// language of tag, or an empty string if the calendar uses the same months as the
+	// Gregorian calendar, in which case the locale's month names are used.
+	MonthName(tag language.Tag, d CalendarDate, abbrev bool) string
+}
+
+var (
+	// strftimeCalendarLock protects strftimeCalendars, which can be updated at runtime
+	// by RegisterCalendar
+	strftimeCalendarLock sync.RWMutex
+
+	// strftimeCalendars maps the values of the ca (calendar) Unicode extension to their
+	// implementation. The Gregorian calendar is nil.
+	strftimeCalendars = map[string]Calendar{
+		"gregory":          nil,
+		"buddhist":         buddhistCalendar{},
+		"chinese":          chineseCalendar{},
+		"dangi":            chineseCalendar{korean: true},
+		"hebrew":           hebrewCalendar{},
+		"islamic":          ummAlQuraCalendar{},
+		"islamic-civil":    islamicCivilCalendar,
+		"islamic-tbla":     islamicTblaCalendar,
+		"islamic-umalqura": ummAlQuraCalendar{},
+		"islamicc":         islamicCivilCalendar, // deprecated alias of islamic-civil
+		"japanese":         japaneseCalendar{},
+		"julian":           julianCalendar{},
+		"persian":          persianCalendar{},
+		"roc":              minguoCalendar{},
+	}
+)
+
+// RegisterCalendar makes calendar c available under the given CLDR calendar identifier,
+// for the ca Unicode extension (as in th-u-ca-buddhist) and Formatter.WithCalendar. A
+// calendar already registered under the same name is replaced.
+//
+// Parameters:
+//   - name: CLDR identifier of the calendar, such as "buddhist" or "japanese"
+//   - c: Calendar implementation
+//
+// Returns: Error if the name is empty or c is nil
+func RegisterCalendar(name string, c Calendar) error {
+	if name == "" || c == nil {
+		return errors.New("strftime: RegisterCalendar called without a name or calendar")
+	}
+	strftimeCalendarLock.Lock()
+	defer strftimeCalendarLock.Unlock()
+	strftimeCalendars[name] = c
+	return nil
+}
+
+// lookupCalendar returns the calendar registered under name, which is nil for the
+// Gregorian calendar. ok is false if there is none.
+func lookupCalendar(name string) (c Calendar, ok bool) {
+	strftimeCalendarLock.RLock()
+	defer strftimeCalendarLock.RUnlock()
+	c, ok = strftimeCalendars[name]
+	return
+}
+
+// eraYears is implemented by built-in calendars whose months and days are the Gregorian
+// ones, and whose years are Gregorian years counted from another epoch, such as the
+// Buddhist calendar or the eras of the Japanese calendar. Their era years can be parsed.
+type eraYears interface {
+	// gregorianYear returns the Gregorian year of year y of era
+	gregorianYear(era, y int) int
+
+	// eras returns the eras of the calendar, whose names are matched when parsing
+	eras() []int
+}
+
+// eraFormatter is implemented by built-in calendars that have their own era formats
+// (%Ec, %Ex and %EX), which replace the locale's ones when the calendar is selected.
+type eraFormatter interface {
+	// eraFormats returns the date and time, date and time formats for locale l
+	eraFormats(l *Locale) (dt, d, t string)
+}
+
+// setCalendar makes locale l use calendar c (nil for the Gregorian calendar).
+func setCalendar(l *Locale, c Calendar) {
+	l.Calendar, l.Eyear = c, nil
+	switch c := c.(type) {
+	case nil:
+		// Gregorian calendar: era formats are the same as the normal ones
+		l.DTfmtEra, l.DfmtEra, l.TfmtEra = "", "", ""
+	case eraFormatter:
+		l.DTfmtEra, l.DfmtEra, l.TfmtEra = c.eraFormats(l)
+	default:
+		// locales without formats of their own for the calendar give its dates in their
+		// usual formats, as the Julian calendar does
+		if l.DfmtEra == "" {
+			l.DTfmtEra, l.DfmtEra = calendarFormat(l, l.DTfmt), calendarFormat(l, l.Dfmt)
+		}
+	}
+}
+
+// localeCalendar returns the calendar of locale l, or nil for the Gregorian calendar.
+func localeCalendar(l *Locale) Calendar {
+	if l.Calendar != nil {
+		return l.Calendar
+	}
+	if l.Eyear != nil {
+		return eraFunc(l.Eyear)
+	}
+	return nil
+}
+
+// calendarDate returns the date of t in the calendar of the locale of fm, or in the
+// calendar in use at that date (see civilDate) if the locale has no calendar.
+func calendarDate(fm *Formatter, t time.Time) CalendarDate {
+	if c := localeCalendar(fm.l); c != nil {
+		return c.Date(t)
+	}
+	y, m, d := civilDate(fm, t)
+	return CalendarDate{Time: t, Year: y, Month: int(m), Day: d}
+}
+
+// gregorianDate returns the date of t in the Gregorian calendar.
+func gregorianDate(t time.Time) CalendarDate {
+	y, m, d := t.Date()
+	return CalendarDate{Time: t, Year: y, Month: int(m), Day: d}
+}
+
+// appendCalendarMonth appends the full or abbreviated name of the month of t in the
+// calendar of the locale of fm.
+func appendCalendarMonth(fm *Formatter, b []byte, t time.Time, abbrev bool) []byte {
+	_, month, _ := civilDate(fm, t)
+	m := int(month)
+	l := fm.l
+	if c := localeCalendar(l); c != nil {
+		d := c.Date(t)
+		if name := c.MonthName(l.Tag, d, abbrev); name != "" {
+			return append(b, name...)
+		}
+		m = d.Month
+	}
+	if m < 1 || m > 12 {
+		// the locale has no name for this month
+		return appendInt(b, m, 1)
+	}
+	if abbrev {
+		return append(b, l.AbMonth[m-1]...)
+	}
+	return append(b, l.Month[m-1]...)
+}
+
+// appendGregorianEra appends era conversion c of date d of the Gregorian or Julian
+// calendars, which have no era: the century ('C'), the year without century ('y'), the
+// year ('Y', 'r' and 'k') or the day of the month ('D'). These calendars have no zodiac
+// nor solar terms, so 'Z', 'S' and 's' give an empty result.
+func appendGregorianEra(b []byte, d CalendarDate, c byte) ([]byte, bool) {
+	switch c {
+	case 'C':
+		return appendInt(b, d.Year/100, 1), true
+	case 'y':
+		return appendInt(b, d.Year%100, 2), true
+	case 'Y', 'r', 'k':
+		return appendInt(b, d.Year, 1), true
+	case 'D':
+		return appendInt(b, d.Day, 1), true
+	case 'Z', 'S', 's':
+		return b, true
+	}
+	return b, false
+}
+
+// julianCalendar is the proleptic Julian calendar, in which every fourth year is a leap
+// year. Months are the same as in the Gregorian calendar, and so are the era conversions
+// (%EC gives the century). Its era formats are the locale's normal formats, with the
+// day, month and year taken in the Julian calendar.
+type julianCalendar struct{}
+
+// julianFromDayNumber returns the Julian calendar date of Julian day number jdn.
+func julianFromDayNumber(jdn int) (int, time.Month, int) {
+	c := jdn + 32082
+	d := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*d, 4)
+	m := (5*e + 2) / 153
+	return d - 4800 + m/10, time.Month(m + 3 - 12*(m/10)), e - (153*m+2)/5 + 1
+}
+
+// julianToDayNumber returns the Julian day number of the Julian calendar date y-m-d.
+func julianToDayNumber(y int, m time.Month, d int) int {
+	a := (14 - int(m)) / 12
+	y, mm := y+4800-a, int(m)+12*a-3
+	return d + (153*mm+2)/5 + 365*y + floorDiv(y, 4) - 32083
+}
+
+// Date returns the Julian calendar date of t.
+func (julianCalendar) Date(t time.Time) CalendarDate {
+	y, m, d := julianFromDayNumber(dayNumber(t.Date()))
+	return CalendarDate{Time: t, Year: y, Month: int(m), Day: d}
+}
+
+// AppendEra appends the century, year or day, as for the Gregorian calendar.
+func (julianCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	return appendGregorianEra(b, d, c)
+}
+
+// MonthName returns an empty string, as months are the Gregorian ones.
+func (julianCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return ""
+}
+
+// eraFormats returns the locale's date and time and date formats with the day, month
+// and year conversions in the calendar, and the locale's time format.
+func (julianCalendar) eraFormats(l *Locale) (dt, d, t string) {
+	return calendarFormat(l, l.DTfmt), calendarFormat(l, l.Dfmt), ""
+}
+
+// calendarFormat returns format f with the day, month and year conversions (such as %d,
+// %B or %Y) replaced by their E variants, which use the locale's calendar. The short
+// date formats %D, %F and %x are expanded first.
+func calendarFormat(l *Locale, f string) string {
+	var b []byte
+	for len(f) > 0 {
+		i := strings.IndexByte(f, '%')
+		if i == -1 {
+			break
+		}
+		b, f = append(b, f[:i+1]...), f[i+1:]
+
+		// flags and field width
+		n := 0
+		for n < len(f) && (isFlag(f[n]) || f[n] >= '0' && f[n] <= '9') {
+			n++
+		}
+		if n == len(f) {
+			break
+		}
+		switch c := f[n]; c {
+		case 'C', 'd', 'e', 'm', 'y', 'Y', 'B', 'b', 'h':
+			b = append(append(b, f[:n]...), 'E', c)
+		case 'D', 'F', 'x':
+			if n == 0 {
+				b = append(b[:len(b)-1], calendarFormat(l, compositeFormat(l, 0, c))...)
+				break
+			}
+			b = append(b, f[:n+1]...)
+		default:
+			b = append(b, f[:n+1]...)
+		}
+		f = f[n+1:]
+	}
+	return string(append(b, f...))
+}
+
+// civilDate returns the date of t in the calendar in use at that date: the Julian
+// calendar before the Gregorian cutover set with Formatter.WithGregorianCutover, and the
+// Gregorian calendar otherwise.
+func civilDate(fm *Formatter, t time.Time) (year int, month time.Month, day int) {
+	year, month, day = t.Date()
+	if fm.cutover != 0 {
+		return civilFromDayNumber(fm, dayNumber(year, month, day))
+	}
+	return
+}
+
+// civilFromDayNumber returns the date of Julian day number jdn in the calendar in use at
+// that date.
+func civilFromDayNumber(fm *Formatter, jdn int) (int, time.Month, int) {
+	if fm.cutover != 0 && jdn < fm.cutover {
+		return julianFromDayNumber(jdn)
+	}
+	return dateFromDayNumber(jdn)
+}
+
+// civilYearDay returns the day of the year of t in the calendar in use at that date,
+// from 1. In the year of the cutover, the days skipped by the reform are not counted.
+func civilYearDay(fm *Formatter, t time.Time) int {
+	if fm.cutover == 0 {
+		return t.YearDay()
+	}
+	y, _, _ := civilDate(fm, t)
+	return dayNumber(t.Date()) - civilDayNumber(fm, y, time.January, 1) + 1
+}
+
+// civilDayNumber returns the Julian day number of date y-m-d in the calendar in use at
+// that date. Dates skipped by the reform are taken in the Gregorian calendar, and fall
+// before the cutover.
+func civilDayNumber(fm *Formatter, y int, m time.Month, d int) int {
+	if fm.cutover != 0 {
+		if jdn := julianToDayNumber(y, m, d); jdn < fm.cutover {
+			return jdn
+		}
+	}
+	return dayNumber(y, m, d)
+}
+
+// isDualYear reports whether the year of t is written with both its Old Style and New
+// Style numbers (see Formatter.WithDualYear), which is the case for Julian calendar dates
+// from January 1st to March 24th, when the year started on March 25th.
+func isDualYear(fm *Formatter, t time.Time) bool {
+	if !fm.dualYear || fm.cutover == 0 {
+		return false
+	}
+	y, m, d := t.Date()
+	jdn := dayNumber(y, m, d)
+	if jdn >= fm.cutover {
+		return false
+	}
+	_, m, d = julianFromDayNumber(jdn)
+	return m < time.March || m == time.March && d < 25
+}
+
+// appendDualYear appends New Style year y with its Old Style year, as in 1720/21, or
+// 1699/1700 when the century changes.
+func appendDualYear(b []byte, y int) []byte {
+	b = append(appendInt(b, y-1, 1), '/')
+	if floorDiv(y-1, 100) != floorDiv(y, 100) {
+		return appendInt(b, y, 1)
+	}
+	return appendInt(b, y%100, 2)
+}
+
+// eraFunc adapts the Locale.Eyear function of locales that predate Calendar. Dates are
+// Gregorian, and only the era conversions use the function.
+type eraFunc func(time.Time, byte) string
+
+// Date returns the Gregorian date of t.
+func (f eraFunc) Date(t time.Time) CalendarDate {
+	return gregorianDate(t)
+}
+
+// AppendEra appends the result of the function for conversion c.
+func (f eraFunc) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	s := f(d.Time, c)
+	if s == "%E"+string(c) {
+		return b, false
+	}
+	return append(b, s...), true
+}
+
+// MonthName returns an empty string, as months are the Gregorian ones.
+func (f eraFunc) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return ""
+}
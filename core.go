@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // appendStrftime formats a time according to the specified format string and locale information,
@@ -14,7 +15,7 @@ import (
 // formatting based on the conversion specifiers (% directives).
 //
 // Parameters:
-//   - l: Pointer to locale information for language-specific formatting
+//   - fm: Formatter holding the locale and settings to format with
 //   - b: Byte slice to append formatted output to
 //   - f: Format string as bytes with strftime-style directives
 //   - t: Time value to format
@@ -29,9 +30,9 @@ import (
 //   - %c - Preferred date and time representation
 //   - %C - Century (year/100)
 //   - %d - Day of month as decimal (01-31)
-//   - %D - Equivalent to %m/%d/%y
+//   - %D - Short date with two-digit year (%m/%d/%y in English)
 //   - %e - Day of month as decimal with leading space (1-31)
-//   - %f - Microseconds
+//   - %f - Microseconds (%3f for milliseconds, or any number of digits)
 //   - %F - Equivalent to %Y-%m-%d (ISO 8601)
 //   - %g - Last two digits of ISO week-based year
 //   - %G - ISO week-based year
@@ -41,13 +42,16 @@ import (
 //   - %j - Day of year (001-366)
 //   - %k - Hour with leading space (0-23)
 //   - %l - Hour with leading space (1-12)
+//   - %L - Milliseconds (3 digits)
 //   - %m - Month as decimal (01-12)
 //   - %M - Minute (00-59)
 //   - %n - Newline character
+//   - %N - Nanoseconds (9 digits, %3N for milliseconds or any number of digits)
 //   - %p - AM or PM
+//   - %Q - Milliseconds since Unix epoch (%:Q for microseconds, %::Q for nanoseconds)
 //   - %P - am or pm
 //   - %r - Time in 12-hour format with AM/PM
-//   - %R - Time in 24-hour format (%H:%M)
+//   - %R - Time in 24-hour format without seconds (%H:%M in most locales)
 //   - %s - Seconds since Unix epoch
 //   - %S - Second (00-60)
 //   - %t - Tab character
@@ -57,7 +61,7 @@ import (
 //   - %v - Date in form of %e-%b-%Y
 //   - %V - ISO week number (01-53)
 //   - %w - Weekday as decimal (0-6, Sunday=0)
-//   - %W - Week number (00-53, Monday as first day)
+//   - %W - Week number (00-53, Monday, or the day set with the fw extension, as first day)
 //   - %x - Preferred date representation
 //   - %X - Preferred time representation
 //   - %y - Year without century (00-99)
@@ -67,10 +71,27 @@ import (
 //   - %% - Percent sign
 //
 // Extended modifiers supported (before specifier):
-//   - %E - Alternative format (for date/time) - depends on locale, mainly used for era-based dates
+//   - %E - Alternative format (for date/time) - depends on locale, mainly used for era-based dates.
+//     %Er and %Ek are extensions giving the era year with the romanized (R6) or
+//     single-kanji (令6) era abbreviation. %Ed, %Ee, %Em, %EB and %Eb give the day, month
+//     and month name in the locale's calendar (see Calendar), and %Eg the last two digits
+//     of the year in that calendar (49 for the Buddhist Era year 2549)
 //   - %O - Alternative numeral format - depends on locale, mainly used for non-latin numerals
-//   - %- - No zero padding
-func appendStrftime(l *strftimeLocaleInfo, b []byte, f []byte, t time.Time) []byte {
+//
+// Time zone offsets can be written as %z (+hhmm), %:z (+hh:mm), %::z (+hh:mm:ss) or %:::z
+// (+hh, adding minutes and seconds only when needed). Seconds are always included for
+// offsets that are not a whole number of minutes. With the E modifier (%Ez, %E:z...),
+// UTC is written as Z, as in RFC 3339 timestamps.
+//
+// GNU flags and field width can be specified between the % sign and the modifier or
+// specifier, as in %-d, %_H, %010Y or %^a:
+//   - - - No padding for numeric values
+//   - _ - Pad numeric values with spaces
+//   - 0 - Pad numeric values (and text, if a width is given) with zeros
+//   - ^ - Convert the result to uppercase
+//   - # - Swap the case of the result (uppercase names, lowercase %p and %Z)
+//   - A decimal number sets the minimum field width; text is padded with spaces
+func appendStrftime(fm *Formatter, b []byte, f []byte, t time.Time) []byte {
 	var skip, i int
 
 	// Preallocate additional space in the buffer if needed
@@ -97,271 +118,703 @@ func appendStrftime(l *strftimeLocaleInfo, b []byte, f []byte, t time.Time) []by
 			return append(b, f...)
 		}
 
-		skip = 2 // number of bytes to skip
+		b, skip = appendDirective(fm, b, f, t)
 
-		switch f[1] {
-		case 'E':
-			if len(f) < 3 {
-				// not enough data to process
-				skip = 0
-				break
+		// move f pointer
+		if skip == 0 {
+			b = append(b, f[0])
+			f = f[1:]
+		} else {
+			f = f[skip:]
+		}
+	}
+	return b
+}
+
+// appendDirective formats the conversion specification at the start of f (which always
+// starts with a % sign and is at least two bytes long) and appends the result to b.
+//
+// Returns: The extended byte slice and the number of bytes of f that were consumed, or 0
+// if the conversion specification is not recognized (in which case b is left unchanged)
+func appendDirective(fm *Formatter, b []byte, f []byte, t time.Time) ([]byte, int) {
+	if !isFlag(f[1]) && (f[1] < '1' || f[1] > '9') {
+		// fast path: no flags or field width
+		return appendConversion(fm, b, f, t)
+	}
+
+	// parse flags and field width
+	var s convSpec
+	i := 1
+	for ; i < len(f) && isFlag(f[i]); i++ {
+		switch f[i] {
+		case '^', '#':
+			s.caseFlag = f[i]
+		default:
+			s.pad = f[i]
+		}
+	}
+	for ; i < len(f) && f[i] >= '0' && f[i] <= '9'; i++ {
+		s.width = s.width*10 + int(f[i]-'0')
+	}
+	if i >= len(f) {
+		// not enough data to process
+		return b, 0
+	}
+
+	// f[i-1:] is the conversion specification without flags and width (as
+	// appendConversion never looks at the % sign itself)
+	b, skip := appendSpec(fm, b, f[i-1:], s, t)
+	if skip == 0 {
+		return b, 0
+	}
+	return b, skip + i - 1
+}
+
+// appendSpec formats conversion specification f, which has no flags or field width
+// (f[0] is never looked at), with the flags and field width of s, and appends the
+// result to b.
+//
+// Returns: The extended byte slice and the number of bytes of f that were consumed, or 0
+// if the conversion specification is not recognized (in which case b is left unchanged)
+func appendSpec(fm *Formatter, b []byte, f []byte, s convSpec, t time.Time) ([]byte, int) {
+	start := len(b)
+	b, skip := appendConversion(fm, b, f, t)
+	if skip == 0 {
+		return b, 0
+	}
+
+	pad, width := s.pad, s.width
+	if d := fractionDigits(f[1]); d > 0 {
+		// the field width is the number of digits of fractional seconds
+		if width > 0 {
+			d = width
+		}
+		b = appendFraction(b[:start], t.Nanosecond(), d)
+		if pad == '-' {
+			b = trimFraction(b, start)
+		}
+		return b, skip
+	}
+
+	if v, w, p, ok := numericValue(fm, f, t); ok {
+		// numbers are formatted again with the requested padding
+		switch pad {
+		case '-':
+			p = 0
+		case '_':
+			p = ' '
+		case '0':
+			p = '0'
+		}
+		if width > 0 {
+			w = width
+		}
+		if f[1] == 'O' {
+			b = appendAltNumber(fm, b[:start], f[2], v, w, p)
+		} else {
+			b = appendIntPad(b[:start], v, w, p)
+		}
+		return b, skip
+	}
+
+	if s.caseFlag != 0 {
+		res := string(b[start:])
+		c := f[1]
+		if c == 'E' || c == 'O' {
+			c = f[2]
+		}
+		if s.caseFlag == '#' && (c == 'p' || c == 'Z') {
+			// %#p and %#Z swap the case of values that are normally uppercase
+			res = strings.ToLower(res)
+		} else {
+			res = strings.ToUpper(res)
+		}
+		b = append(b[:start], res...)
+	}
+
+	if n := utf8.RuneCount(b[start:]); n < width && pad != '-' {
+		// pad text on the left
+		p := byte(' ')
+		if pad == '0' {
+			p = '0'
+		}
+		n = width - n
+		b = append(b, make([]byte, n)...)
+		copy(b[start+n:], b[start:len(b)-n])
+		for j := start; j < start+n; j++ {
+			b[j] = p
+		}
+	}
+	return b, skip
+}
+
+// isFlag reports whether c is a GNU strftime flag character.
+func isFlag(c byte) bool {
+	return c == '-' || c == '_' || c == '0' || c == '^' || c == '#'
+}
+
+// hour24 returns the hour of t for %H and %k, which is 24 instead of 0 with the h24
+// hour cycle.
+func hour24(fm *Formatter, t time.Time) int {
+	h := t.Hour()
+	if h == 0 && fm.hourCycle == "h24" {
+		return 24
+	}
+	return h
+}
+
+// weekDay returns the day of t in the weeks of %W, from 0 for their first day (Monday,
+// unless set otherwise with the fw extension) to 6.
+func (fm *Formatter) weekDay(t time.Time) int {
+	return (int(t.Weekday()) + 6 - fm.weekStart) % 7
+}
+
+// hour12 returns the hour of t for %I and %l, from 1 to 12, or from 0 to 11 with the
+// h11 hour cycle.
+func hour12(fm *Formatter, t time.Time) int {
+	h := t.Hour() % 12
+	if h == 0 && fm.hourCycle != "h11" {
+		// Noon is 12PM, midnight is 12AM.
+		return 12
+	}
+	return h
+}
+
+// fractionDigits returns the default number of digits of fractional seconds conversion
+// c, or 0 if c is not a fractional seconds conversion.
+func fractionDigits(c byte) int {
+	switch c {
+	case 'f':
+		return 6
+	case 'L':
+		return 3
+	case 'N':
+		return 9
+	}
+	return 0
+}
+
+// trimFraction removes trailing zeros from the fractional seconds appended to b after
+// offset start. If no digit remains, a decimal point right before them is removed too.
+func trimFraction(b []byte, start int) []byte {
+	for len(b) > start && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+	if len(b) == start && start > 0 && b[start-1] == '.' {
+		b = b[:start-1]
+	}
+	return b
+}
+
+// fractionPrecision returns the largest number of digits of fractional seconds output
+// by format f, including in composite conversions, or -1 if f has no fractional seconds.
+func fractionPrecision(fm *Formatter, f string) int {
+	prec := -1
+	for {
+		i := strings.IndexByte(f, '%')
+		if i == -1 {
+			return prec
+		}
+		f = f[i:]
+
+		s, ok := scanSpec(f)
+		if !ok {
+			return prec
+		}
+		if known, era := knownConversion(f, s); !known && !era {
+			f = f[1:]
+			continue
+		}
+
+		mod, c := strings.TrimRight(f[s.conv:s.letter], ":"), f[s.letter]
+		switch colons := s.letter - s.conv - len(mod); {
+		case c == 'Q' && (mod == "" || mod == "O"):
+			// 3 digits for %Q, 6 for %:Q and 9 for %::Q
+			prec = max(prec, 3*(colons+1))
+		case fractionDigits(c) > 0 && mod == "":
+			d := fractionDigits(c)
+			if s.width > 0 {
+				d = s.width
 			}
-			skip = 3
-			// Era modifier
-			switch f[2] {
-			case 'c':
-				if l.DTfmtEra != "" {
-					b = appendStrftime(l, b, []byte(l.DTfmtEra), t)
-				} else {
-					b = appendStrftime(l, b, []byte(l.DTfmt), t)
-				}
-			case 'C':
-				if l.Eyear != nil {
-					b = append(b, []byte(l.Eyear(t, 'C'))...)
-				} else {
-					b = appendInt(b, t.Year()/100, 1)
-				}
-			case 'x':
-				if l.DfmtEra != "" {
-					b = appendStrftime(l, b, []byte(l.DfmtEra), t)
-				} else {
-					b = appendStrftime(l, b, []byte(l.Dfmt), t)
-				}
-			case 'X':
-				if l.TfmtEra != "" {
-					b = appendStrftime(l, b, []byte(l.TfmtEra), t)
-				} else {
-					b = appendStrftime(l, b, []byte(l.Tfmt), t)
-				}
-			case 'y':
-				if l.Eyear != nil {
-					b = append(b, []byte(l.Eyear(t, 'y'))...)
-				} else {
-					b = appendInt(b, t.Year()%100, 2)
-				}
-			case 'Y':
-				if l.Eyear != nil {
-					b = append(b, []byte(l.Eyear(t, 'Y'))...)
-				} else {
-					b = appendInt(b, t.Year(), 1)
-				}
-			default:
-				skip = 0
+			prec = max(prec, d)
+		case mod == "":
+			if sub := fm.composite(0, c); sub != "" {
+				prec = max(prec, fractionPrecision(fm, sub))
 			}
-		case 'O':
-			if len(f) < 3 {
-				// not enough data to process
-				skip = 0
-				break
+		case mod == "E":
+			if sub := fm.composite('E', c); sub != "" {
+				prec = max(prec, fractionPrecision(fm, sub))
 			}
-			skip = 3
-			// alternative digits output (japanese, etc)
-			var v uint8
-			switch f[2] {
-			case 'd', 'e': // day (two decimals)
-				v = uint8(t.Day())
-			case 'H':
-				v = uint8(t.Hour())
-			case 'I':
-				// Noon is 12PM, midnight is 12AM.
-				v = uint8(t.Hour() % 12)
-				if v == 0 {
-					v = 12
-				}
-			case 'm':
-				v = uint8(t.Month())
-			case 'M':
-				v = uint8(t.Minute())
-			case 'S':
-				v = uint8(t.Second())
-			case 'U':
-				v = uint8(((t.YearDay() - 1) - int(t.Weekday()) + 7) / 7)
-			case 'V':
-				_, w := t.ISOWeek()
-				v = uint8(w)
-			case 'w':
-				v = uint8(t.Weekday())
-			case 'W': // same as %U, but with monday
-				wday := int(t.Weekday()+6) % 7 // weekday but Monday = 0
-				v = uint8(((t.YearDay() - 1) - wday + 7) / 7)
-			case 'y':
-				v = uint8(t.Year() % 100)
-			default:
-				skip = 0
+		}
+		f = f[s.end:]
+	}
+}
+
+// adjustTime converts t to the location set with the tz extension, if any, and rounds
+// it to the precision of the fractional seconds output by format f, if rounding was
+// enabled with Formatter.WithRounding.
+func adjustTime(fm *Formatter, f string, t time.Time) time.Time {
+	if fm.loc != nil {
+		t = t.In(fm.loc)
+	}
+	if !fm.round {
+		return t
+	}
+	return t.Round(fractionUnit(fractionPrecision(fm, f)))
+}
+
+// fractionUnit returns the duration of the last digit of fractional seconds shown with
+// prec digits, or 0 if prec is negative.
+func fractionUnit(prec int) time.Duration {
+	if prec < 0 {
+		return 0
+	}
+	d := time.Duration(1)
+	for ; prec < 9; prec++ {
+		d *= 10
+	}
+	return d
+}
+
+// numericValue returns the numeric value of conversion specification f (which does not
+// include any flag or field width), along with its default width and padding character
+// (0 for no padding). ok is false if the conversion does not produce a decimal number.
+func numericValue(fm *Formatter, f []byte, t time.Time) (v int64, width int, pad byte, ok bool) {
+	c := f[1]
+	switch c {
+	case 'E':
+		if len(f) < 3 {
+			return 0, 0, 0, false
+		}
+		switch c = f[2]; c {
+		case 'd', 'e', 'g', 'm':
+			d := calendarDate(fm, t)
+			switch c {
+			case 'd':
+				return int64(d.Day), 2, '0', true
+			case 'e':
+				return int64(d.Day), 2, ' ', true
+			case 'g':
+				return int64(d.Year % 100), 2, '0', true
 			}
-			if skip != 0 {
-				if l.Oprint != nil {
-					b = l.Oprint(b, int(v))
-				} else {
-					switch f[2] {
-					case 'e':
-						b = appendUint8Sp(b, v, 2)
-					case 'w', 'W':
-						b = appendUint8(b, v, 1)
-					default:
-						b = appendUint8(b, v, 2)
-					}
-				}
+			return int64(d.Month), 2, '0', true
+		case 'C', 'y', 'Y':
+			if localeCalendar(fm.l) != nil {
+				return 0, 0, 0, false
 			}
-		case '-':
-			if len(f) < 3 {
-				// not enough data to process
+		default:
+			return 0, 0, 0, false
+		}
+	case 'O':
+		if len(f) < 3 || hasAlgorithmicDigits(fm, f[2]) {
+			return 0, 0, 0, false
+		}
+		c = f[2]
+		if c == 'E' {
+			// E conversion written with the numbering system, as in %OEd
+			return numericValue(fm, f[1:], t)
+		}
+		if c == 'Q' {
+			return epochValue(t, 0), 1, '0', true
+		}
+	case 'Q', ':':
+		colons, c := colonConversion(f[1:])
+		if c != 'Q' || colons > 2 {
+			return 0, 0, 0, false
+		}
+		return epochValue(t, colons), 1, '0', true
+	}
+
+	switch c {
+	case 'C':
+		y, _, _ := civilDate(fm, t)
+		return int64(y / 100), 1, '0', true
+	case 'd':
+		_, _, d := civilDate(fm, t)
+		return int64(d), 2, '0', true
+	case 'e':
+		_, _, d := civilDate(fm, t)
+		return int64(d), 2, ' ', true
+	case 'g':
+		y, _ := t.ISOWeek()
+		return int64(y % 100), 2, '0', true
+	case 'G':
+		y, _ := t.ISOWeek()
+		return int64(y), 1, '0', true
+	case 'H':
+		return int64(hour24(fm, t)), 2, '0', true
+	case 'I', 'l':
+		h := hour12(fm, t)
+		if c == 'l' {
+			return int64(h), 2, ' ', true
+		}
+		return int64(h), 2, '0', true
+	case 'j':
+		return int64(civilYearDay(fm, t)), 3, '0', true
+	case 'k':
+		return int64(hour24(fm, t)), 2, ' ', true
+	case 'm':
+		_, m, _ := civilDate(fm, t)
+		return int64(m), 2, '0', true
+	case 'M':
+		return int64(t.Minute()), 2, '0', true
+	case 's':
+		return t.Unix(), 1, '0', true
+	case 'S':
+		return int64(t.Second()), 2, '0', true
+	case 'u':
+		return int64((int(t.Weekday()+6) % 7) + 1), 1, '0', true
+	case 'U':
+		return int64(((civilYearDay(fm, t) - 1) - int(t.Weekday()) + 7) / 7), 2, '0', true
+	case 'V':
+		_, w := t.ISOWeek()
+		return int64(w), 2, '0', true
+	case 'w':
+		return int64(t.Weekday()), 1, '0', true
+	case 'W':
+		return int64(((civilYearDay(fm, t) - 1) - fm.weekDay(t) + 7) / 7), 2, '0', true
+	case 'y':
+		y, _, _ := civilDate(fm, t)
+		return int64(y % 100), 2, '0', true
+	case 'Y':
+		y, _, _ := civilDate(fm, t)
+		return int64(y), 1, '0', true
+	}
+	return 0, 0, 0, false
+}
+
+// appendConversion formats the conversion specification at the start of f, which has
+// no flags or field width, and appends the result to b. f[0] is never looked at.
+//
+// Returns: The extended byte slice and the number of bytes of f that were consumed, or 0
+// if the conversion specification is not recognized (in which case b is left unchanged)
+func appendConversion(fm *Formatter, b []byte, f []byte, t time.Time) ([]byte, int) {
+	l := fm.l
+	skip := 2 // number of bytes to skip
+
+	switch f[1] {
+	case 'E':
+		if len(f) < 3 {
+			// not enough data to process
+			skip = 0
+			break
+		}
+		skip = 3
+		// Era modifier
+		switch f[2] {
+		case 'c', 'x', 'X': // composite formats
+			b = appendStrftime(fm, b, []byte(fm.composite('E', f[2])), t)
+		case 'd', 'e', 'g', 'm': // day, month and two-digit year in the calendar
+			v, w, pad, _ := numericValue(fm, f, t)
+			b = appendIntPad(b, v, w, pad)
+		case 'B': // month name in the calendar
+			b = appendCalendarMonth(fm, b, t, false)
+		case 'b', 'h': // abbreviated month name in the calendar
+			b = appendCalendarMonth(fm, b, t, true)
+		case 'z', ':': // time zone offset, with Z for UTC
+			colons, c := colonConversion(f[2:])
+			if c != 'z' {
 				skip = 0
 				break
 			}
-			skip = 3
-			// no zero padding modified
-			switch f[2] {
-			case 'd': // day (two decimals)
-				b = appendUint8(b, uint8(t.Day()), 1)
-			case 'H':
-				b = appendUint8(b, uint8(t.Hour()), 1)
-			case 'I':
-				// Noon is 12PM, midnight is 12AM.
-				h := t.Hour() % 12
-				if h == 0 {
-					h = 12
-				}
-				b = appendUint8(b, uint8(h), 1)
-			case 'j':
-				b = appendInt(b, t.YearDay(), 1)
-			case 'm':
-				b = appendUint8(b, uint8(t.Month()), 1)
-			case 'M':
-				b = appendUint8(b, uint8(t.Minute()), 1)
-			case 'S':
-				b = appendUint8(b, uint8(t.Second()), 1)
-			default:
-				skip = 0
+			skip += colons
+			_, z := t.Zone()
+			if z == 0 {
+				b = append(b, 'Z')
+			} else {
+				b = appendOffset(b, z, colons)
 			}
-		case 'a': // day (abbreviated)
-			b = append(b, []byte(l.AbDay[t.Weekday()])...)
-		case 'A': // day
-			b = append(b, []byte(l.Day[t.Weekday()])...)
-		case 'b', 'h': // month (abbreviated)
-			b = append(b, []byte(l.AbMonth[int(t.Month())-1])...)
-		case 'B': // month
-			b = append(b, []byte(l.Month[int(t.Month())-1])...)
-		case 'c': // date & time format
-			b = appendStrftime(l, b, []byte(l.DTfmt), t)
-		case 'C': // century part of year
-			b = appendInt(b, t.Year()/100, 1)
-		case 'd': // day (two decimals)
-			b = appendUint8(b, uint8(t.Day()), 2)
-		case 'D': // date (month/day/year format)
-			b = appendStrftime(l, b, []byte("%m/%d/%y"), t)
-		case 'e': // day
-			b = appendUint8Sp(b, uint8(t.Day()), 2)
-		case 'f':
-			b = appendInt(b, t.Nanosecond()/1000, 6)
-		case 'F':
-			b = appendStrftime(l, b, []byte("%Y-%m-%d"), t)
-		case 'g':
-			y, _ := t.ISOWeek()
-			b = appendInt(b, y%100, 2)
-		case 'G':
-			y, _ := t.ISOWeek()
-			b = appendInt(b, y, 1)
-		case 'H':
-			b = appendUint8(b, uint8(t.Hour()), 2)
-		case 'I':
-			// Noon is 12PM, midnight is 12AM.
-			h := t.Hour() % 12
-			if h == 0 {
-				h = 12
+		default:
+			var ok bool
+			if c := localeCalendar(fm.l); c != nil {
+				b, ok = c.AppendEra(b, l.Tag, c.Date(t), f[2])
+			} else {
+				// Gregorian calendar, which has no era
+				b, ok = appendGregorianEra(b, calendarDate(fm, t), f[2])
 			}
-			b = appendUint8(b, uint8(h), 2)
-		case 'j':
-			b = appendInt(b, t.YearDay(), 3)
-		case 'k':
-			b = appendUint8Sp(b, uint8(t.Hour()), 2)
-		case 'l':
-			// Noon is 12PM, midnight is 12AM.
-			h := t.Hour() % 12
-			if h == 0 {
-				h = 12
+			if !ok {
+				skip = 0
 			}
-			b = appendUint8Sp(b, uint8(h), 2)
-		case 'm':
-			b = appendUint8(b, uint8(t.Month()), 2)
-		case 'M':
-			b = appendUint8(b, uint8(t.Minute()), 2)
-		case 'n':
-			b = append(b, '\n')
-		case 'p':
-			if t.Hour() >= 12 {
-				b = append(b, []byte(strings.ToUpper(l.AmPm[1]))...)
-			} else {
-				b = append(b, []byte(strings.ToUpper(l.AmPm[0]))...)
+		}
+	case 'O':
+		if len(f) < 3 {
+			// not enough data to process
+			skip = 0
+			break
+		}
+		skip = 3
+		switch f[2] {
+		case 'b', 'h': // month (abbreviated, standalone form)
+			_, m, _ := civilDate(fm, t)
+			b = append(b, []byte(l.AbAltMonth[m-1])...)
+		case 'B': // month (standalone form)
+			_, m, _ := civilDate(fm, t)
+			b = append(b, []byte(l.AltMonth[m-1])...)
+		case 'E': // E conversion written with the numbering system, as in %OEY
+			start := len(b)
+			var n int
+			if b, n = appendConversion(fm, b, f[1:], t); n == 0 {
+				skip = 0
+				break
 			}
-		case 'P':
-			if t.Hour() >= 12 {
-				b = append(b, []byte(strings.ToLower(l.AmPm[1]))...)
-			} else {
-				b = append(b, []byte(strings.ToLower(l.AmPm[0]))...)
+			skip = 1 + n
+			b = altDigitRuns(fm, b, start, f[n])
+		default:
+			b, skip = appendAltDigits(fm, b, f[2], t)
+		}
+	case 'a': // day (abbreviated)
+		b = append(b, []byte(l.AbDay[t.Weekday()])...)
+	case 'A': // day
+		b = append(b, []byte(l.Day[t.Weekday()])...)
+	case 'b', 'h': // month (abbreviated)
+		_, m, _ := civilDate(fm, t)
+		b = append(b, []byte(l.AbMonth[m-1])...)
+	case 'B': // month
+		_, m, _ := civilDate(fm, t)
+		b = append(b, []byte(l.Month[m-1])...)
+	case 'c', 'D', 'r', 'R', 'T', 'v', 'x', 'X': // composite formats
+		b = appendStrftime(fm, b, []byte(fm.composite(0, f[1])), t)
+	case 'F': // ISO 8601 date (%Y-%m-%d), which never has the dual years of WithDualYear
+		y, m, d := civilDate(fm, t)
+		b = append(appendInt(b, y, 1), '-')
+		b = append(appendUint8(b, uint8(m), 2), '-')
+		b = appendUint8(b, uint8(d), 2)
+	case 'C': // century part of year
+		y, _, _ := civilDate(fm, t)
+		b = appendInt(b, y/100, 1)
+	case 'd': // day (two decimals)
+		_, _, d := civilDate(fm, t)
+		b = appendUint8(b, uint8(d), 2)
+	case 'e': // day
+		_, _, d := civilDate(fm, t)
+		b = appendUint8Sp(b, uint8(d), 2)
+	case 'f', 'L', 'N': // fractional seconds
+		b = appendFraction(b, t.Nanosecond(), fractionDigits(f[1]))
+	case 'g':
+		y, _ := t.ISOWeek()
+		b = appendInt(b, y%100, 2)
+	case 'G':
+		y, _ := t.ISOWeek()
+		b = appendInt(b, y, 1)
+	case 'H':
+		b = appendUint8(b, uint8(hour24(fm, t)), 2)
+	case 'I':
+		b = appendUint8(b, uint8(hour12(fm, t)), 2)
+	case 'j':
+		b = appendInt(b, civilYearDay(fm, t), 3)
+	case 'k':
+		b = appendUint8Sp(b, uint8(hour24(fm, t)), 2)
+	case 'l':
+		b = appendUint8Sp(b, uint8(hour12(fm, t)), 2)
+	case 'm':
+		_, m, _ := civilDate(fm, t)
+		b = appendUint8(b, uint8(m), 2)
+	case 'M':
+		b = appendUint8(b, uint8(t.Minute()), 2)
+	case 'n':
+		b = append(b, '\n')
+	case 'p':
+		if t.Hour() >= 12 {
+			b = append(b, []byte(strings.ToUpper(l.AmPm[1]))...)
+		} else {
+			b = append(b, []byte(strings.ToUpper(l.AmPm[0]))...)
+		}
+	case 'P':
+		if t.Hour() >= 12 {
+			b = append(b, []byte(strings.ToLower(l.AmPm[1]))...)
+		} else {
+			b = append(b, []byte(strings.ToLower(l.AmPm[0]))...)
+		}
+	case 's':
+		b = appendInt64(b, t.Unix(), 1)
+	case 'S':
+		b = appendUint8(b, uint8(t.Second()), 2)
+	case 't':
+		b = append(b, '\t')
+	case 'u':
+		wday := (int(t.Weekday()+6) % 7) + 1 // weekday but Monday = 1
+		b = appendUint8(b, uint8(wday), 1)
+	case 'U':
+		b = appendUint8(b, uint8(((civilYearDay(fm, t)-1)-int(t.Weekday())+7)/7), 2)
+	case 'V':
+		_, w := t.ISOWeek()
+		b = appendUint8(b, uint8(w), 2)
+	case 'w':
+		b = appendUint8(b, uint8(t.Weekday()), 1)
+	case 'W': // same as %U, but with monday, or the first day set with the fw extension
+		b = appendUint8(b, uint8(((civilYearDay(fm, t)-1)-fm.weekDay(t)+7)/7), 2)
+	case 'y':
+		y, _, _ := civilDate(fm, t)
+		b = appendInt(b, y%100, 2)
+	case 'Y':
+		y, _, _ := civilDate(fm, t)
+		if isDualYear(fm, t) {
+			b = appendDualYear(b, y)
+		} else {
+			b = appendInt(b, y, 1)
+		}
+	case 'z', 'Q', ':':
+		colons, c := colonConversion(f[1:])
+		switch {
+		case c == 'z': // time zone offset
+			_, z := t.Zone()
+			b = appendOffset(b, z, colons)
+		case c == 'Q' && colons < 3: // milli, micro or nanoseconds since Unix epoch
+			b = appendInt64(b, epochValue(t, colons), 1)
+		default:
+			skip = 0
+		}
+		if skip != 0 {
+			skip += colons
+		}
+	case 'Z':
+		n, _ := t.Zone()
+		b = append(b, []byte(n)...)
+	case '%':
+		b = append(b, '%')
+	default:
+		skip = 0
+	}
+	return b, skip
+}
+
+// appendAltDigits formats the number for %Oc with the locale's numbering system (such
+// as Arabic-Indic digits or Japanese numerals) and appends it to b.
+//
+// Returns: The extended byte slice and the number of bytes of the conversion
+// specification, or 0 if c is not a numeric conversion
+func appendAltDigits(fm *Formatter, b []byte, c byte, t time.Time) ([]byte, int) {
+	v, w, pad, ok := numericValue(fm, []byte{'%', c}, t)
+	if !ok {
+		return b, 0
+	}
+	return appendAltNumber(fm, b, c, v, w, pad), 3
+}
+
+// colonConversion returns the number of colons (up to 3) found at the start of f, which
+// starts right after the % sign or modifier, and the conversion letter that follows them,
+// as in %::z or %:Q. c is 0 if f ends before the conversion letter.
+func colonConversion(f []byte) (colons int, c byte) {
+	for colons < len(f) && colons < 3 && f[colons] == ':' {
+		colons++
+	}
+	if colons >= len(f) {
+		return colons, 0
+	}
+	return colons, f[colons]
+}
+
+// epochValue returns the time elapsed since the Unix epoch in milliseconds (0 colon),
+// microseconds (1) or nanoseconds (2). Like %s, values are rounded down, so that times
+// before 1970 keep their place in the order of values.
+func epochValue(t time.Time, colons int) int64 {
+	switch colons {
+	case 1:
+		return t.UnixMicro()
+	case 2:
+		return t.UnixNano()
+	}
+	return t.UnixMilli()
+}
+
+// appendOffset appends time zone offset z (in seconds east of UTC) to b, as +hhmm for
+// 0 colons, +hh:mm for 1, +hh:mm:ss for 2 and with only the needed precision (+hh,
+// +hh:mm or +hh:mm:ss) for 3. Seconds are always included when not zero.
+func appendOffset(b []byte, z, colons int) []byte {
+	if z < 0 {
+		b = append(b, '-')
+		z = -z
+	} else {
+		b = append(b, '+')
+	}
+	h, m, s := z/3600, z/60%60, z%60
+
+	b = appendInt(b, h, 2)
+	if colons == 3 && m == 0 && s == 0 {
+		return b
+	}
+	if colons > 0 {
+		b = append(b, ':')
+	}
+	b = appendUint8(b, uint8(m), 2)
+	if colons == 2 || s != 0 {
+		if colons > 0 {
+			b = append(b, ':')
+		}
+		b = appendUint8(b, uint8(s), 2)
+	}
+	return b
+}
+
+// compositeFormat returns the format string that the composite conversion specification
+// c (with modifier mod, which can be 0 or 'E') expands to in locale l, or an empty string
+// if c is not a composite conversion.
+func compositeFormat(l *Locale, mod, c byte) string {
+	if mod == 'E' {
+		switch c {
+		case 'c':
+			if l.DTfmtEra != "" {
+				return l.DTfmtEra
 			}
-		case 'r':
-			b = appendStrftime(l, b, []byte("%I:%M:%S %p"), t)
-		case 'R':
-			b = appendStrftime(l, b, []byte("%H:%M"), t)
-		case 's':
-			b = appendInt64(b, t.Unix(), 1)
-		case 'S':
-			b = appendUint8(b, uint8(t.Second()), 2)
-		case 't':
-			b = append(b, '\t')
-		case 'T':
-			b = appendStrftime(l, b, []byte("%H:%M:%S"), t)
-		case 'u':
-			wday := (int(t.Weekday()+6) % 7) + 1 // weekday but Monday = 1
-			b = appendUint8(b, uint8(wday), 1)
-		case 'U':
-			b = appendUint8(b, uint8(((t.YearDay()-1)-int(t.Weekday())+7)/7), 2)
-		case 'v': // non-standard extension found in https://github.com/lestrrat-go/strftime
-			b = appendStrftime(l, b, []byte("%e-%b-%Y"), t)
-		case 'V':
-			_, w := t.ISOWeek()
-			b = appendUint8(b, uint8(w), 2)
-		case 'w':
-			b = appendUint8(b, uint8(t.Weekday()), 1)
-		case 'W': // same as %U, but with monday
-			wday := int(t.Weekday()+6) % 7 // weekday but Monday = 0
-			b = appendUint8(b, uint8(((t.YearDay()-1)-wday+7)/7), 2)
 		case 'x':
-			b = appendStrftime(l, b, []byte(l.Dfmt), t)
+			if l.DfmtEra != "" {
+				return l.DfmtEra
+			}
 		case 'X':
-			b = appendStrftime(l, b, []byte(l.Tfmt), t)
-		case 'y':
-			b = appendInt(b, t.Year()%100, 2)
-		case 'Y':
-			b = appendInt(b, t.Year(), 1)
-		case 'z':
-			_, z := t.Zone()
-			z = z / 60 // convert seconds → minutes
-			if z < 0 {
-				b = append(b, '-')
-				z = -z
-			} else {
-				b = append(b, '+')
+			if l.TfmtEra != "" {
+				return l.TfmtEra
 			}
-			b = appendUint8(b, uint8(z/60), 2)
-			b = appendUint8(b, uint8(z%60), 2)
-		case 'Z':
-			n, _ := t.Zone()
-			b = append(b, []byte(n)...)
-		case '%':
-			b = append(b, '%')
 		default:
-			skip = 0
+			return ""
 		}
+	}
 
-		// move f pointer
-		if skip == 0 {
-			b = append(b, f[0])
-			f = f[1:]
-		} else {
-			f = f[skip:]
+	switch c {
+	case 'c': // date & time format
+		return l.DTfmt
+	case 'D': // short date, month/day/year in POSIX
+		if l.DfmtShort != "" {
+			return l.DfmtShort
+		}
+		return "%m/%d/%y"
+	case 'F':
+		return "%Y-%m-%d"
+	case 'r': // 12-hour time
+		if l.Tfmt12 != "" {
+			return l.Tfmt12
 		}
+		return "%I:%M:%S %p"
+	case 'R':
+		if l.TfmtShort != "" {
+			return l.TfmtShort
+		}
+		return "%H:%M"
+	case 'T':
+		return "%H:%M:%S"
+	case 'v': // non-standard extension found in https://github.com/lestrrat-go/strftime
+		return "%e-%b-%Y"
+	case 'x':
+		return l.Dfmt
+	case 'X':
+		return l.Tfmt
 	}
-	return b
+	return ""
+}
+
+// composite returns the format string that the composite conversion specification c
+// (with modifier mod, which can be 0 or 'E') expands to when formatting with fm, or an
+// empty string if c is not expanded. %D, %r and %R have their POSIX definitions with
+// WithPOSIX, and %F is not expanded, as appendConversion formats it without dual years.
+func (fm *Formatter) composite(mod, c byte) string {
+	if mod == 0 {
+		switch c {
+		case 'D', 'r', 'R':
+			if fm.posix {
+				return compositeFormat(rootLocale, 0, c)
+			}
+		case 'F':
+			return ""
+		}
+	}
+	return compositeFormat(fm.l, mod, c)
 }
@@ -0,0 +1,14 @@
+package strftime
+
+// Internals used by the tests of package strftime_test.
+var (
+	TwelveHourFormat     = twelveHourFormat
+	TwentyFourHourFormat = twentyFourHourFormat
+)
+
+// ResetJapaneseEras removes the eras added with RegisterJapaneseEra.
+func ResetJapaneseEras() {
+	japaneseErasLock.Lock()
+	defer japaneseErasLock.Unlock()
+	japaneseEras = append([]japaneseEra(nil), japaneseBuiltinEras[:]...)
+}
@@ -0,0 +1,369 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// strftimeTimeZones maps the BCP 47 time zone identifiers used by the tz Unicode extension
+// to IANA time zone names. Only the most common zones are listed, CheckExtensions reports
+// the others.
+var strftimeTimeZones = map[string]string{
+	"utc":     "UTC",
+	"gmt":     "Etc/GMT",
+	"aedxb":   "Asia/Dubai",
+	"arbue":   "America/Argentina/Buenos_Aires",
+	"atvie":   "Europe/Vienna",
+	"aumel":   "Australia/Melbourne",
+	"auper":   "Australia/Perth",
+	"ausyd":   "Australia/Sydney",
+	"bebru":   "Europe/Brussels",
+	"brsao":   "America/Sao_Paulo",
+	"cator":   "America/Toronto",
+	"cavan":   "America/Vancouver",
+	"chzrh":   "Europe/Zurich",
+	"cnsha":   "Asia/Shanghai",
+	"czprg":   "Europe/Prague",
+	"deber":   "Europe/Berlin",
+	"dkcph":   "Europe/Copenhagen",
+	"egcai":   "Africa/Cairo",
+	"esmad":   "Europe/Madrid",
+	"fihel":   "Europe/Helsinki",
+	"frpar":   "Europe/Paris",
+	"gblon":   "Europe/London",
+	"grath":   "Europe/Athens",
+	"hkhkg":   "Asia/Hong_Kong",
+	"idjkt":   "Asia/Jakarta",
+	"iedub":   "Europe/Dublin",
+	"inccu":   "Asia/Kolkata",
+	"irthr":   "Asia/Tehran",
+	"itrom":   "Europe/Rome",
+	"jeruslm": "Asia/Jerusalem",
+	"jptyo":   "Asia/Tokyo",
+	"krsel":   "Asia/Seoul",
+	"mxmex":   "America/Mexico_City",
+	"nglos":   "Africa/Lagos",
+	"nlams":   "Europe/Amsterdam",
+	"nooso":   "Europe/Oslo",
+	"nzakl":   "Pacific/Auckland",
+	"phmnl":   "Asia/Manila",
+	"pkkhi":   "Asia/Karachi",
+	"plwaw":   "Europe/Warsaw",
+	"ptlis":   "Europe/Lisbon",
+	"rumow":   "Europe/Moscow",
+	"saruh":   "Asia/Riyadh",
+	"sesto":   "Europe/Stockholm",
+	"sgsin":   "Asia/Singapore",
+	"thbkk":   "Asia/Bangkok",
+	"trist":   "Europe/Istanbul",
+	"twtpe":   "Asia/Taipei",
+	"uaiev":   "Europe/Kyiv",
+	"usanc":   "America/Anchorage",
+	"uschi":   "America/Chicago",
+	"usden":   "America/Denver",
+	"ushnl":   "Pacific/Honolulu",
+	"uslax":   "America/Los_Angeles",
+	"usnyc":   "America/New_York",
+	"usphx":   "America/Phoenix",
+	"vnsgn":   "Asia/Ho_Chi_Minh",
+	"zajnb":   "Africa/Johannesburg",
+}
+
+// hasExtensions reports whether tag carries Unicode extension keys used for formatting.
+func hasExtensions(tag language.Tag) bool {
+	for _, key := range [...]string{"hc", "nu", "ca", "tz", "fw"} {
+		if tag.TypeForKey(key) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// unicodeType returns the value of Unicode extension key of tag, including all its
+// subtags (as in islamic-civil), where tag.TypeForKey only returns the first one.
+func unicodeType(tag language.Tag, key string) string {
+	ext, ok := tag.Extension('u')
+	if !ok {
+		return ""
+	}
+	var res []string
+	found := false
+	for _, sub := range strings.Split(ext.String(), "-")[1:] {
+		switch {
+		case len(sub) == 2: // start of a key
+			if found {
+				return strings.Join(res, "-")
+			}
+			found = sub == key
+		case found:
+			res = append(res, sub)
+		}
+	}
+	return strings.Join(res, "-")
+}
+
+// stripExtensions returns tag without its extensions, so that it can be looked up in
+// the locale table.
+func stripExtensions(tag language.Tag) language.Tag {
+	if len(tag.Extensions()) == 0 {
+		return tag
+	}
+	base, script, region := tag.Raw()
+	parts := []interface{}{base, script, region}
+	for _, v := range tag.Variants() {
+		parts = append(parts, v)
+	}
+	res, err := language.Raw.Compose(parts...)
+	if err != nil {
+		return tag
+	}
+	return res
+}
+
+// applyExtensions returns Formatter fm modified according to the Unicode extensions of
+// tag (hc, nu, ca, tz and fw), or fm itself if tag has none. Unknown values are ignored.
+func applyExtensions(fm *Formatter, tag language.Tag) *Formatter {
+	if !hasExtensions(tag) {
+		return fm
+	}
+	res := *fm
+	ca, hasCalendar := lookupCalendar(unicodeType(tag, "ca"))
+	hc := tag.TypeForKey("hc")
+	if hasCalendar || hc != "" {
+		// the calendar and hour cycle change the formats of the locale
+		loc := *fm.l
+		res.l = &loc
+	}
+	loc := res.l
+
+	if hasCalendar {
+		setCalendar(loc, ca)
+	}
+
+	if ns := lookupNumberingSystem(tag.TypeForKey("nu")); ns != nil {
+		res.numbers = ns
+	}
+
+	if z := lookupTimeZone(tag.TypeForKey("tz")); z != nil {
+		res.loc = z
+	}
+
+	if d := weekStart(tag.TypeForKey("fw")); d != -1 {
+		res.weekStart = d
+	}
+
+	switch hc {
+	case "h11", "h12":
+		res.hourCycle = hc
+		tfmt12 := loc.Tfmt12
+		if tfmt12 == "" {
+			tfmt12 = "%I:%M:%S %p"
+		}
+		if !isTwelveHourFormat(loc.Tfmt) {
+			loc.Tfmt = tfmt12
+		}
+		loc.DTfmt = twelveHourFormat(loc.DTfmt, tfmt12)
+		loc.DTfmtEra = twelveHourFormat(loc.DTfmtEra, tfmt12)
+		loc.TfmtEra = twelveHourFormat(loc.TfmtEra, tfmt12)
+	case "h23", "h24":
+		res.hourCycle = hc
+		loc.Tfmt = twentyFourHourFormat(loc.Tfmt, "%T")
+		loc.DTfmt = twentyFourHourFormat(loc.DTfmt, "%X")
+		loc.DTfmtEra = twentyFourHourFormat(loc.DTfmtEra, "%X")
+		loc.TfmtEra = twentyFourHourFormat(loc.TfmtEra, "%T")
+	}
+
+	return &res
+}
+
+// lookupTimeZone returns the location of BCP 47 time zone id, or nil if it is unknown
+// or cannot be loaded.
+func lookupTimeZone(id string) *time.Location {
+	name, ok := strftimeTimeZones[id]
+	if !ok {
+		return nil
+	}
+	z, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	return z
+}
+
+// weekStart returns the number of days from Monday to day, a value of the fw extension
+// such as sun, or -1 if day is not a day of the week.
+func weekStart(day string) int {
+	for i, d := range [...]string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"} {
+		if day == d {
+			return i
+		}
+	}
+	return -1
+}
+
+// CheckExtensions reports the values of the Unicode extensions of tag that New ignores
+// because they are not supported, such as an unknown calendar or a time zone missing
+// from the tz table.
+//
+// Parameters:
+//   - tag: Language tag, as given to New
+//
+// Returns: nil if all the hc, nu, ca, tz and fw values of tag are supported, or an error
+// listing the others
+func CheckExtensions(tag language.Tag) error {
+	var bad []string
+	if hc := tag.TypeForKey("hc"); hc != "" && hc != "h11" && hc != "h12" && hc != "h23" && hc != "h24" {
+		bad = append(bad, "hc-"+hc)
+	}
+	if nu := tag.TypeForKey("nu"); nu != "" && lookupNumberingSystem(nu) == nil {
+		bad = append(bad, "nu-"+nu)
+	}
+	if ca := unicodeType(tag, "ca"); ca != "" {
+		if _, ok := lookupCalendar(ca); !ok {
+			bad = append(bad, "ca-"+ca)
+		}
+	}
+	if tz := tag.TypeForKey("tz"); tz != "" && lookupTimeZone(tz) == nil {
+		bad = append(bad, "tz-"+tz)
+	}
+	if fw := tag.TypeForKey("fw"); fw != "" && weekStart(fw) == -1 {
+		bad = append(bad, "fw-"+fw)
+	}
+	if len(bad) == 0 {
+		return nil
+	}
+	return errors.New("strftime: unsupported Unicode extension values " + strings.Join(bad, ", ") + " in " + tag.String())
+}
+
+// isTwelveHourFormat reports whether format f shows the hour on a 12-hour clock.
+func isTwelveHourFormat(f string) bool {
+	found := false
+	scanFormat(f, func(pos, conv, end int) bool {
+		c := conversionChar(f, conv)
+		found = c == 'I' || c == 'l' || c == 'r'
+		return !found
+	})
+	return found
+}
+
+// conversionChar returns the conversion character at offset conv of format f, as found
+// by scanFormat, or 0 for E conversions, which are never hours nor AM/PM indicators.
+func conversionChar(f string, conv int) byte {
+	if f[conv-1] == 'E' {
+		return 0
+	}
+	return f[conv]
+}
+
+// findConversion returns the offsets of the start and end of the first conversion
+// specification of f from offset from whose conversion character is in chars, or -1 if
+// there is none.
+func findConversion(f string, from int, chars string) (start, end int) {
+	start, end = -1, -1
+	scanFormat(f, func(pos, conv, e int) bool {
+		if pos < from || strings.IndexByte(chars, conversionChar(f, conv)) == -1 {
+			return true
+		}
+		start, end = pos, e
+		return false
+	})
+	return start, end
+}
+
+// replaceConversions returns format f with the conversion characters found in the keys of
+// repl replaced by the corresponding strings, keeping flags, field width and modifiers.
+// Conversions whose replacement starts with a % sign, such as %T for %H:%M:%S, are only
+// replaced when they have no flags, field width or modifier.
+func replaceConversions(f string, repl map[byte]string) string {
+	var b strings.Builder
+	last := 0
+	scanFormat(f, func(pos, conv, end int) bool {
+		r, ok := repl[conversionChar(f, conv)]
+		if !ok || (strings.HasPrefix(r, "%") && conv != pos+1) {
+			return true
+		}
+		if strings.HasPrefix(r, "%") {
+			b.WriteString(f[last:pos])
+		} else {
+			b.WriteString(f[last:conv])
+		}
+		b.WriteString(r)
+		last = end
+		return true
+	})
+	b.WriteString(f[last:])
+	return b.String()
+}
+
+// twelveHourFormat converts format f to use a 12-hour clock, placing the AM/PM indicator
+// like tfmt12 (the locale's 12-hour time format) does: before the hour, or after the
+// time. Formats without a 24-hour clock hour are returned as is.
+func twelveHourFormat(f, tfmt12 string) string {
+	f = replaceConversions(f, map[byte]string{'T': "%H:%M:%S", 'R': "%H:%M"})
+	pos, _ := findConversion(f, 0, "Hk")
+	if pos == -1 {
+		return f
+	}
+	f = replaceConversions(f, map[byte]string{'H': "I", 'k': "l"})
+	if p, _ := findConversion(f, 0, "pP"); p != -1 {
+		// the format already has an AM/PM indicator
+		return f
+	}
+
+	at, atEnd := findConversion(tfmt12, 0, "pP")
+	ampm := "%p"
+	if at != -1 {
+		ampm = tfmt12[at:atEnd]
+	}
+	if hour, _ := findConversion(tfmt12, 0, "Il"); at != -1 && at < hour {
+		// the indicator comes first, as in %p %I時%M分
+		if strings.HasPrefix(tfmt12[atEnd:], " ") {
+			ampm += " "
+		}
+		return f[:pos] + ampm + f[pos:]
+	}
+
+	// the indicator comes after the minutes or seconds, and any unit following them
+	_, end := findConversion(f, pos, "Il")
+	for _, c := range [...]string{"M", "S"} {
+		if _, e := findConversion(f, end, c); e != -1 {
+			end = e
+		}
+	}
+	for end < len(f) && f[end] != ' ' && f[end] != '%' {
+		end++
+	}
+	return f[:end] + " " + ampm + f[end:]
+}
+
+// twentyFourHourFormat converts format f to use a 24-hour clock, replacing %r with r24
+// and removing AM/PM indicators along with a space next to them.
+func twentyFourHourFormat(f, r24 string) string {
+	if !isTwelveHourFormat(f) {
+		return f
+	}
+	f = replaceConversions(f, map[byte]string{'r': r24, 'I': "H", 'l': "k"})
+
+	var b strings.Builder
+	last := 0
+	scanFormat(f, func(pos, conv, end int) bool {
+		if c := conversionChar(f, conv); c != 'p' && c != 'P' {
+			return true
+		}
+		text := f[last:pos]
+		if strings.HasSuffix(text, " ") {
+			text = text[:len(text)-1]
+		} else if end < len(f) && f[end] == ' ' {
+			end++
+		}
+		b.WriteString(text)
+		last = end
+		return true
+	})
+	b.WriteString(f[last:])
+	return b.String()
+}
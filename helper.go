@@ -182,3 +182,99 @@ func appendUint8Sp(b []byte, u uint8, width int) []byte {
 
 	return append(b, buf[i:]...)
 }
+
+// appendIntPad appends a decimal representation of int64 x to byte slice b,
+// ensuring minimum width with the given padding character.
+//
+// Parameters:
+//   - b: Destination byte slice to append to
+//   - x: 64-bit integer value to format
+//   - width: Minimum width of the result, including the sign if any
+//   - pad: Padding character ('0' or ' '), or 0 for no padding
+//
+// Returns: The extended byte slice with the formatted integer appended
+func appendIntPad(b []byte, x int64, width int, pad byte) []byte {
+	u := uint64(x)
+	if x < 0 {
+		u = uint64(-x)
+	}
+
+	// Assemble decimal in reverse order.
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		q := u / 10
+		buf[i] = byte('0' + u - q*10)
+		u = q
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	n := len(buf) - i // number of characters, including the sign
+	if x < 0 {
+		n++
+	}
+
+	if pad == ' ' {
+		for ; n < width; n++ {
+			b = append(b, ' ')
+		}
+	}
+	if x < 0 {
+		b = append(b, '-')
+	}
+	if pad == '0' {
+		for ; n < width; n++ {
+			b = append(b, '0')
+		}
+	}
+
+	return append(b, buf[i:]...)
+}
+
+// appendFraction appends the first digits of the fractional seconds represented by
+// ns nanoseconds to byte slice b, truncating the value. Digits beyond nanoseconds
+// are output as zeros.
+//
+// Parameters:
+//   - b: Destination byte slice to append to
+//   - ns: Nanoseconds, between 0 and 999999999
+//   - digits: Number of digits to output
+//
+// Returns: The extended byte slice with the fractional seconds appended
+func appendFraction(b []byte, ns, digits int) []byte {
+	if digits >= 9 {
+		b = appendInt(b, ns, 9)
+		for ; digits > 9; digits-- {
+			b = append(b, '0')
+		}
+		return b
+	}
+	for i := digits; i < 9; i++ {
+		ns /= 10
+	}
+	return appendInt(b, ns, digits)
+}
+
+// localDigits replaces the ASCII digits appended to b after offset start with the
+// given digits, for numbering systems such as Arabic-Indic or Devanagari.
+//
+// Parameters:
+//   - b: Byte slice holding the formatted number
+//   - start: Offset of the formatted number in b
+//   - digits: Representation of digits 0 to 9
+//
+// Returns: The byte slice with the number converted
+func localDigits(b []byte, start int, digits *[10]string) []byte {
+	n := append([]byte(nil), b[start:]...)
+	b = b[:start]
+	for _, c := range n {
+		if c >= '0' && c <= '9' {
+			b = append(b, digits[c-'0']...)
+		} else {
+			b = append(b, c)
+		}
+	}
+	return b
+}
@@ -0,0 +1,537 @@
+// Command cldrgen generates strftime locale tables from a local checkout of the
+// CLDR JSON data (https://github.com/unicode-org/cldr-json).
+//
+// It reads the gregorian calendar data of every locale found in the
+// cldr-dates-full (or cldr-dates-modern) package, converts the CLDR date and time
+// patterns to strftime patterns, and writes a Go source file registering one Locale
+// per CLDR locale. Locales already defined by hand in the strftime package take
+// precedence over the generated ones. Patterns using fields that strftime cannot
+// express, such as eras or flexible day periods (B), are logged: an availableFormats
+// pattern is then replaced with the corresponding dateFormats or timeFormats pattern,
+// and a locale whose dateFormats, timeFormats or dateTimeFormats cannot be converted
+// is skipped.
+//
+// Usage:
+//
+//	go run ./internal/cldrgen -cldr /path/to/cldr-json -o locale_cldr.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// cldrGregorian is the subset of ca-gregorian.json used to build a locale.
+type cldrGregorian struct {
+	Main map[string]struct {
+		Dates struct {
+			Calendars struct {
+				Gregorian struct {
+					Months struct {
+						Format     cldrWidths `json:"format"`
+						StandAlone cldrWidths `json:"stand-alone"`
+					} `json:"months"`
+					Days struct {
+						Format cldrWidths `json:"format"`
+					} `json:"days"`
+					DayPeriods struct {
+						Format cldrWidths `json:"format"`
+					} `json:"dayPeriods"`
+					DateFormats     map[string]json.RawMessage `json:"dateFormats"`
+					TimeFormats     map[string]json.RawMessage `json:"timeFormats"`
+					DateTimeFormats struct {
+						Medium           json.RawMessage   `json:"medium"`
+						AvailableFormats map[string]string `json:"availableFormats"`
+					} `json:"dateTimeFormats"`
+				} `json:"gregorian"`
+			} `json:"calendars"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+// cldrWidths holds the abbreviated and wide forms of a set of names.
+type cldrWidths struct {
+	Abbreviated map[string]string `json:"abbreviated"`
+	Wide        map[string]string `json:"wide"`
+}
+
+// locale is the generated data for a single locale, mirroring strftime.Locale.
+type locale struct {
+	Tag    string
+	DTfmt  string
+	Dfmt   string
+	Tfmt   string
+	Tfmt12 string
+	AmPm   [2]string
+
+	DfmtShort string
+	TfmtShort string
+
+	AbDay   [7]string
+	Day     [7]string
+	AbMonth [12]string
+	Month   [12]string
+
+	// standalone month names, only set when they differ from the format ones
+	AbAltMonth [12]string
+	AltMonth   [12]string
+}
+
+// cldrDays lists CLDR day keys in strftime order (Sunday first).
+var cldrDays = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+func main() {
+	dir := flag.String("cldr", "", "path to a checkout of the cldr-json repository")
+	out := flag.String("o", "locale_cldr.go", "output file")
+	flag.Parse()
+
+	if *dir == "" {
+		log.Fatal("cldrgen: -cldr is required")
+	}
+
+	locales, err := readLocales(*dir)
+	if err != nil {
+		log.Fatalf("cldrgen: %s", err)
+	}
+
+	src, err := generate(locales)
+	if err != nil {
+		log.Fatalf("cldrgen: %s", err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("cldrgen: %s", err)
+	}
+	log.Printf("cldrgen: wrote %d locales to %s", len(locales), *out)
+}
+
+// readLocales loads all locales with gregorian calendar data found under dir.
+func readLocales(dir string) ([]*locale, error) {
+	var files []string
+	for _, pkg := range []string{"cldr-dates-full", "cldr-dates-modern"} {
+		m, err := filepath.Glob(filepath.Join(dir, pkg, "main", "*", "ca-gregorian.json"))
+		if err != nil {
+			return nil, err
+		}
+		if len(m) > 0 {
+			files = m
+			break
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no ca-gregorian.json files found in %s", dir)
+	}
+	sort.Strings(files)
+
+	var res []*locale
+	for _, fn := range files {
+		l, err := readLocale(fn)
+		if errors.Is(err, errUnsupported) {
+			log.Printf("cldrgen: skipping %s: %s", fn, err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fn, err)
+		}
+		if l != nil {
+			res = append(res, l)
+		}
+	}
+	return dropInherited(res), nil
+}
+
+// dropInherited removes the locales whose data is the same as that of their closest
+// parent in locales, as the strftime locale matcher falls back to the parent anyway.
+func dropInherited(locales []*locale) []*locale {
+	byTag := make(map[language.Tag]*locale, len(locales))
+	for _, l := range locales {
+		byTag[language.MustParse(l.Tag)] = l
+	}
+
+	var res []*locale
+	for _, l := range locales {
+		for tag := language.MustParse(l.Tag).Parent(); tag != language.Und; tag = tag.Parent() {
+			if p, ok := byTag[tag]; ok {
+				if sameData(p, l) {
+					l = nil
+				}
+				break
+			}
+		}
+		if l != nil {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// sameData reports whether a and b only differ by their tag.
+func sameData(a, b *locale) bool {
+	x, y := *a, *b
+	x.Tag, y.Tag = "", ""
+	return x == y
+}
+
+// readLocale converts a single ca-gregorian.json file, returning nil for locales
+// that cannot be represented by a language tag (such as root).
+func readLocale(fn string) (*locale, error) {
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	var doc cldrGregorian
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	for id, m := range doc.Main {
+		if id == "root" || id == "und" {
+			return nil, nil
+		}
+		tag, err := language.Parse(id)
+		if err != nil {
+			// some CLDR identifiers have no BCP 47 equivalent
+			return nil, nil
+		}
+		g := m.Dates.Calendars.Gregorian
+
+		l := &locale{Tag: tag.String()}
+		for i, k := range cldrDays {
+			l.AbDay[i] = g.Days.Format.Abbreviated[k]
+			l.Day[i] = g.Days.Format.Wide[k]
+		}
+		for i := 0; i < 12; i++ {
+			k := strconv.Itoa(i + 1)
+			l.AbMonth[i] = g.Months.Format.Abbreviated[k]
+			l.Month[i] = g.Months.Format.Wide[k]
+		}
+		abAlt, alt := standAlone(g.Months.StandAlone.Abbreviated, l.AbMonth), standAlone(g.Months.StandAlone.Wide, l.Month)
+		if abAlt != l.AbMonth || alt != l.Month {
+			l.AbAltMonth, l.AltMonth = abAlt, alt
+		}
+		l.AmPm = [2]string{g.DayPeriods.Format.Abbreviated["am"], g.DayPeriods.Format.Abbreviated["pm"]}
+
+		// the first pattern that cannot be converted fails the locale (err is nil here)
+		convert := func(p string, year yearWidth) string {
+			res, e := convertPattern(p, year)
+			if err == nil {
+				err = e
+			}
+			return res
+		}
+		// availableFormats patterns are optional, def is kept if p cannot be converted
+		convertAvailable := func(skel, def string) string {
+			p, ok := g.DateTimeFormats.AvailableFormats[skel]
+			if !ok {
+				return def
+			}
+			res, e := convertPattern(p, patternYear)
+			if e != nil {
+				log.Printf("cldrgen: %s: ignoring availableFormats %s: %s", id, skel, e)
+				return def
+			}
+			return res
+		}
+
+		date := convert(rawString(g.DateFormats["short"]), fullYear)
+		medDate := convert(rawString(g.DateFormats["medium"]), patternYear)
+		medTime := convert(rawString(g.TimeFormats["medium"]), patternYear)
+
+		l.Dfmt = date
+		l.Tfmt = convertAvailable("Hms", medTime)
+		// the CLDR data may have no 12-hour pattern, use the POSIX one unless the
+		// locale already uses a 12-hour clock
+		tfmt12 := "%I:%M:%S %p"
+		if strings.Contains(medTime, "%p") {
+			tfmt12 = medTime
+		}
+		l.Tfmt12 = convertAvailable("hms", tfmt12)
+
+		// %D always has a two digit year, %R hours and minutes
+		l.DfmtShort = convert(rawString(g.DateFormats["short"]), shortYear)
+		l.TfmtShort = convertAvailable("Hm", convert(rawString(g.TimeFormats["short"]), patternYear))
+
+		dt := rawString(g.DateTimeFormats.Medium)
+		if dt == "" {
+			dt = "{1} {0}"
+		}
+		dt = strings.ReplaceAll(dt, "{1}", "\x01")
+		dt = strings.ReplaceAll(dt, "{0}", "\x00")
+		dt = convert(dt, patternYear)
+		dt = strings.ReplaceAll(dt, "\x01", medDate)
+		l.DTfmt = strings.ReplaceAll(dt, "\x00", medTime)
+
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	}
+	return nil, fmt.Errorf("no locale data")
+}
+
+// standAlone returns the stand-alone month names found in m, keyed by month number,
+// using the format names for the months m does not have.
+func standAlone(m map[string]string, format [12]string) [12]string {
+	res := format
+	for i := range res {
+		if v := m[strconv.Itoa(i+1)]; v != "" {
+			res[i] = v
+		}
+	}
+	return res
+}
+
+// rawString returns the value of a JSON string, or an empty string if the value is
+// not a string (newer CLDR versions use objects for some patterns).
+func rawString(m json.RawMessage) string {
+	var s string
+	if json.Unmarshal(m, &s) != nil {
+		return ""
+	}
+	return s
+}
+
+// yearWidth selects how convertField converts the year fields of a pattern.
+type yearWidth int
+
+const (
+	patternYear yearWidth = iota // two digit years for yy, full years otherwise
+	fullYear                     // full years, as strftime locales traditionally use %Y in %x
+	shortYear                    // two digit years, as %D always has
+)
+
+// errUnsupported is returned for patterns using fields without strftime equivalent.
+var errUnsupported = errors.New("unsupported field")
+
+// convertPattern converts a CLDR date/time pattern to a strftime pattern, with the
+// year fields converted according to year. Patterns with fields that cannot be
+// converted (see convertField) are rejected.
+func convertPattern(p string, year yearWidth) (string, error) {
+	var b strings.Builder
+	r := []rune(p)
+
+	for i := 0; i < len(r); {
+		c := r[i]
+		n := 1
+		for i+n < len(r) && r[i+n] == c {
+			n++
+		}
+
+		switch {
+		case c == '\'':
+			if n >= 2 {
+				// '' is an escaped quote
+				for j := 0; j < n/2; j++ {
+					b.WriteRune('\'')
+				}
+				i += n / 2 * 2
+				continue
+			}
+			// quoted literal text, until the next single quote
+			i++
+			for i < len(r) {
+				if r[i] == '\'' {
+					if i+1 < len(r) && r[i+1] == '\'' {
+						b.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				writeLiteral(&b, r[i])
+				i++
+			}
+			continue
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			f, err := convertField(c, n, year)
+			if err != nil {
+				return "", fmt.Errorf("%w in pattern %q", err, p)
+			}
+			b.WriteString(f)
+		default:
+			for j := 0; j < n; j++ {
+				writeLiteral(&b, c)
+			}
+		}
+		i += n
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// writeLiteral writes literal text, escaping % signs.
+func writeLiteral(b *strings.Builder, c rune) {
+	if c == '%' {
+		b.WriteString("%%")
+		return
+	}
+	b.WriteRune(c)
+}
+
+// convertField returns the strftime equivalent of a CLDR pattern field made of n
+// times the letter c, or an error for fields without equivalent, such as eras,
+// quarters, weeks and flexible day periods (B).
+func convertField(c rune, n int, year yearWidth) (string, error) {
+	switch c {
+	case 'y', 'Y', 'u':
+		if year == shortYear || n == 2 && year != fullYear {
+			return "%y", nil
+		}
+		return "%Y", nil
+	case 'M', 'L':
+		switch n {
+		case 1:
+			return "%-m", nil
+		case 2:
+			return "%m", nil
+		case 3:
+			return "%b", nil
+		default:
+			return "%B", nil
+		}
+	case 'd':
+		if n == 1 {
+			return "%-d", nil
+		}
+		return "%d", nil
+	case 'D':
+		return "%j", nil
+	case 'E':
+		if n >= 4 {
+			return "%A", nil
+		}
+		return "%a", nil
+	case 'c', 'e':
+		switch {
+		case n >= 4:
+			return "%A", nil
+		case n == 3:
+			return "%a", nil
+		}
+		return "%u", nil
+	case 'a':
+		return "%p", nil
+	case 'h':
+		if n == 1 {
+			return "%-I", nil
+		}
+		return "%I", nil
+	case 'H':
+		if n == 1 {
+			return "%-H", nil
+		}
+		return "%H", nil
+	case 'K':
+		// K counts hours from 0 to 11, strftime has no such field: %I shows 12
+		// instead of 0 in the first hour of the morning and afternoon
+		if n == 1 {
+			return "%-I", nil
+		}
+		return "%I", nil
+	case 'k':
+		// k counts hours from 1 to 24, strftime has no such field: %H shows 0
+		// instead of 24 in the first hour of the day
+		if n == 1 {
+			return "%-H", nil
+		}
+		return "%H", nil
+	case 'm':
+		if n == 1 {
+			return "%-M", nil
+		}
+		return "%M", nil
+	case 's':
+		if n == 1 {
+			return "%-S", nil
+		}
+		return "%S", nil
+	case 'S':
+		// fractional seconds, with as many digits as letters
+		if n > 9 {
+			n = 9
+		}
+		return "%" + strconv.Itoa(n) + "N", nil
+	case 'z', 'v', 'V', 'O':
+		return "%Z", nil
+	case 'Z', 'x', 'X':
+		return "%z", nil
+	}
+	return "", fmt.Errorf("%w %s", errUnsupported, strings.Repeat(string(c), n))
+}
+
+// generate renders the Go source registering the given locales.
+func generate(locales []*locale) ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteString(`// Code generated by cldrgen from CLDR data; DO NOT EDIT.
+
+package strftime
+
+import "golang.org/x/text/language"
+
+// cldrLocales contains the locales generated from CLDR data
+var cldrLocales = []*Locale{
+`)
+	for _, l := range locales {
+		fmt.Fprintf(&b, "\t{\n\t\tTag: language.MustParse(%q),\n", l.Tag)
+		fmt.Fprintf(&b, "\t\tDTfmt: %q,\n\t\tDfmt: %q,\n\t\tTfmt: %q,\n", l.DTfmt, l.Dfmt, l.Tfmt)
+		if l.Tfmt12 != "" {
+			fmt.Fprintf(&b, "\t\tTfmt12: %q,\n", l.Tfmt12)
+		}
+		fmt.Fprintf(&b, "\t\tDfmtShort: %q,\n\t\tTfmtShort: %q,\n", l.DfmtShort, l.TfmtShort)
+		if l.AmPm[0] != "" || l.AmPm[1] != "" {
+			fmt.Fprintf(&b, "\t\tAmPm: %#v,\n", l.AmPm)
+		}
+		fmt.Fprintf(&b, "\t\tAbDay: %#v,\n\t\tDay: %#v,\n", l.AbDay, l.Day)
+		fmt.Fprintf(&b, "\t\tAbMonth: %#v,\n\t\tMonth: %#v,\n", l.AbMonth, l.Month)
+		if l.AltMonth[0] != "" {
+			fmt.Fprintf(&b, "\t\tAbAltMonth: %#v,\n\t\tAltMonth: %#v,\n", l.AbAltMonth, l.AltMonth)
+		}
+		b.WriteString("\t},\n")
+	}
+	b.WriteString(`}
+
+// init adds the CLDR locales that have not been defined by hand
+func init() {
+	known := make(map[language.Tag]bool, len(strftimeLocales))
+	for _, l := range strftimeLocales {
+		known[cldrScriptTag(l.Tag)] = true
+	}
+	for _, l := range cldrLocales {
+		if !known[cldrScriptTag(l.Tag)] {
+			strftimeLocales = append(strftimeLocales, l)
+		}
+	}
+	rebuildLocales()
+}
+
+// cldrScriptTag returns tag with its likely script, as CLDR omits the script of the
+// locales where it is the default (zh for zh-Hans, zh-Hant-HK for zh-HK).
+func cldrScriptTag(tag language.Tag) language.Tag {
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	parts := []interface{}{base, script}
+	if region, conf := tag.Region(); conf == language.Exact {
+		parts = append(parts, region)
+	}
+	if t, err := language.Compose(parts...); err == nil {
+		return t
+	}
+	return tag
+}
+`)
+
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertPattern(t *testing.T) {
+	cmp := []struct {
+		A, B string
+		Year yearWidth
+	}{
+		{`dd/MM/y`, `%d/%m/%Y`, patternYear},
+		{`M/d/yy`, `%-m/%-d/%y`, patternYear},
+		{`M/d/yy`, `%-m/%-d/%Y`, fullYear},
+		{`EEEE d MMMM y`, `%A %-d %B %Y`, patternYear},
+		{`h:mm:ss a`, `%-I:%M:%S %p`, patternYear},
+		{`HH:mm:ss zzzz`, `%H:%M:%S %Z`, patternYear},
+		{`y年M月d日`, `%Y年%-m月%-d日`, patternYear},
+		{`d 'de' MMMM 'de' y`, `%-d de %B de %Y`, patternYear},
+		{`HH 'h' mm`, `%H h %M`, patternYear},
+		{`h 'o''clock' a`, `%-I o'clock %p`, patternYear},
+		{`100% d`, `100%% %-d`, patternYear},
+		{`HH:mm:ss.SSS`, `%H:%M:%S.%3N`, patternYear},
+		{`mm:ss.S`, `%M:%S.%1N`, patternYear},
+		{`dd/MM/y`, `%d/%m/%y`, shortYear},
+		{`'%Y' y`, `%%Y %y`, shortYear},
+		{`K:mm a`, `%-I:%M %p`, patternYear},
+		{`kk:mm`, `%H:%M`, patternYear},
+	}
+
+	for _, x := range cmp {
+		res, err := convertPattern(x.A, x.Year)
+		if assert.NoError(t, err, `converting `+x.A) {
+			assert.Equal(t, x.B, res, `converting `+x.A)
+		}
+	}
+
+	// fields without strftime equivalent are rejected
+	for _, p := range []string{`G y`, `h:mm B`, `QQQ y`, `'week' w`} {
+		_, err := convertPattern(p, patternYear)
+		assert.ErrorIs(t, err, errUnsupported, `converting `+p)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	locales, err := readLocales("testdata")
+	if !assert.NoError(t, err) {
+		return
+	}
+	// root is skipped, fr-BE is the same as fr and my has a pattern using B
+	if !assert.Len(t, locales, 2) {
+		return
+	}
+
+	de := locales[0]
+	assert.Equal(t, `de`, de.Tag)
+	assert.Equal(t, `%d.%m.%y`, de.DfmtShort)
+	assert.Equal(t, `%I:%M:%S %p`, de.Tfmt12, `hms using B is ignored`)
+
+	fr := locales[1]
+	assert.Equal(t, `fr`, fr.Tag)
+	assert.Equal(t, `%d/%m/%Y`, fr.Dfmt)
+	assert.Equal(t, `%H:%M:%S`, fr.Tfmt)
+	assert.Equal(t, `%-I:%M:%S %p`, fr.Tfmt12)
+	assert.Equal(t, `%-d %b %Y, %H:%M:%S`, fr.DTfmt)
+	assert.Equal(t, `%d/%m/%y`, fr.DfmtShort)
+	assert.Equal(t, `%H:%M`, fr.TfmtShort)
+	assert.Equal(t, [2]string{"AM", "PM"}, fr.AmPm)
+	assert.Equal(t, `lundi`, fr.Day[1])
+	assert.Equal(t, `janv.`, fr.AbMonth[0])
+	assert.Equal(t, ``, fr.AltMonth[0], `standalone names are the same as the format ones`)
+
+	src, err := generate(locales)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.Contains(string(src), `language.MustParse("fr"),`), `generated source contains the locale`)
+		assert.True(t, strings.Contains(string(src), `DfmtShort: "%d/%m/%y",`), `generated source contains the short date format`)
+		assert.False(t, strings.Contains(string(src), `AltMonth`), `generated source omits identical standalone names`)
+	}
+}
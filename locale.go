@@ -1,22 +1,40 @@
 // Package strftime provides locale-aware time formatting.
 package strftime
 
+// The CLDR locales of locale_cldr.go can be regenerated from a checkout of
+// https://github.com/unicode-org/cldr-json by setting CLDR_JSON to its path and running
+// go generate. Locales defined by hand in this package take precedence over generated ones.
+//go:generate go run ./internal/cldrgen -cldr ${CLDR_JSON} -o locale_cldr.go
+
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/text/language"
 )
 
-// strftimeLocaleInfo holds all the locale-specific information needed for formatting times.
+// Locale holds all the locale-specific information needed for formatting times.
 // This includes translated day and month names, date format patterns, and special
 // formatting functions for different calendar systems.
-type strftimeLocaleInfo struct {
-	tag language.Tag // The language tag representing this locale
+//
+// Custom locales can be made available to New, Format and Parse through RegisterLocale.
+//
+// Fields left empty are inherited from the parent locales of Tag (for example es-MX
+// inherits from es-419, then es), and finally from a root locale using the POSIX C
+// locale formats and English names.
+type Locale struct {
+	Tag language.Tag // The language tag representing this locale
 
 	DTfmt  string // DateTime format (%c)
 	Dfmt   string // Date format (%x)
 	Tfmt   string // Time format (%X)
-	Tfmt12 string // 12-hour time format with am/pm
+	Tfmt12 string // 12-hour time format with am/pm (%r), defaults to %I:%M:%S %p
+
+	DfmtShort string // Short date format with a two-digit year (%D), defaults to %m/%d/%y
+	TfmtShort string // Hours and minutes format (%R), defaults to %H:%M
 
 	// Era-related formats for calendars with era-based years (like Japanese)
 	DTfmtEra string // Alternative DateTime format with era (%Ec)
@@ -29,155 +47,652 @@ type strftimeLocaleInfo struct {
 	Day   [7]string // Full day names (Sunday-Saturday)
 
 	// Functions for extended formatting
-	Oprint func([]byte, int) []byte     // For %O format - alternative digits (e.g., Japanese numerals)
-	Eyear  func(time.Time, byte) string // For %E format - era-based year formatting (e.g., Japanese era)
-	// byte can be 'C' (century), 'y' (year) or 'Y' (full year)
+	Oprint func([]byte, int) []byte // For %O format - alternative digits, takes precedence over Numbering
+	// Deprecated: Eyear formats era years for %EC, %Ey and %EY only, use Calendar instead.
+	// It is used when Calendar is nil, and gets 'C' (era), 'y' (year) or 'Y' (full year).
+	Eyear func(time.Time, byte) string
+
+	// Calendar used by the E modifier (such as %EY or %Ed) and the era formats, nil for
+	// the Gregorian calendar
+	Calendar Calendar
+
+	// Numbering system used by %O conversions, as a CLDR identifier such as "arab" or
+	// "jpan" (see RegisterNumberingSystem). ASCII digits are used if empty or unknown.
+	Numbering string
 
 	AbMonth [12]string // Abbreviated month names (Jan-Dec)
 	Month   [12]string // Full month names (January-December)
+
+	// Standalone month names (%Ob, %OB), for languages where month names used in a date
+	// (as in "5 января") differ from the names used on their own ("Январь"). In that
+	// case AbMonth and Month hold the names used in a date. Defaults to AbMonth and Month.
+	AbAltMonth [12]string // Abbreviated standalone month names
+	AltMonth   [12]string // Full standalone month names
 }
 
 var (
+	// strftimeLocaleLock protects the locale lists, table and matcher, which can be
+	// updated at runtime by RegisterLocale
+	strftimeLocaleLock sync.RWMutex
+
+	// strftimeResolved holds the locales of strftimeLocales (in the same order) with
+	// missing fields inherited from their parents
+	strftimeResolved []*Locale
+
 	// strftimeLocaleMatcher is used to match requested language tags to available locales
 	strftimeLocaleMatcher language.Matcher
 
 	// strftimeLocaleTable provides fast lookup of locale information by language tag
-	strftimeLocaleTable map[language.Tag]*strftimeLocaleInfo
+	strftimeLocaleTable map[language.Tag]*Locale
 )
 
 // init initializes the locale matcher and lookup table
 func init() {
-	// Initialize and fill the variables
-	strftimeLocaleTable = make(map[language.Tag]*strftimeLocaleInfo)
+	rebuildLocales()
+}
+
+// rebuildLocales resolves the locales of strftimeLocales and fills the lookup table and
+// matcher. The caller must hold strftimeLocaleLock for writing (or be init).
+func rebuildLocales() {
+	defined := make(map[language.Tag]*Locale, len(strftimeLocales))
+	for _, loc := range strftimeLocales {
+		defined[loc.Tag] = loc
+	}
+
+	strftimeResolved = make([]*Locale, len(strftimeLocales))
+	strftimeLocaleTable = make(map[language.Tag]*Locale, len(strftimeLocales))
 	matcherTable := make([]language.Tag, len(strftimeLocales))
 
 	for i, loc := range strftimeLocales {
-		strftimeLocaleTable[loc.tag] = loc
-		matcherTable[i] = loc.tag
+		res := resolveLocale(loc, defined)
+		inheritLocale(res, rootLocale)
+		strftimeResolved[i] = res
+		strftimeLocaleTable[loc.Tag] = res
+		matcherTable[i] = loc.Tag
 	}
 
 	// Create a matcher that can find the closest locale to a requested language
 	strftimeLocaleMatcher = language.NewMatcher(matcherTable)
 }
 
-// strftimeLocales contains all supported locales with their formatting information
-var strftimeLocales = [...]*strftimeLocaleInfo{
+// RegisterLocale makes locale l available to New, Format and Parse. If a locale is
+// already registered for l.Tag, it is replaced, which allows patching the built-in
+// locales. A copy of l is registered, so later changes to l have no effect.
+//
+// Only the fields that differ from the parent locale need to be set, for example a
+// locale for es-MX only needs the formats that differ from Spanish. The locale must
+// however be complete once its parents are taken into account, see CheckLocale.
+//
+// RegisterLocale is safe for concurrent use, however Formatters created before the
+// call keep using the locale they were created with.
+//
+// Parameters:
+//   - l: Locale information, with Tag set to the language it applies to
+//
+// Returns: Error if the locale has no valid language tag, or is incomplete or invalid
+func RegisterLocale(l *Locale) error {
+	if l == nil {
+		return errors.New("strftime: RegisterLocale called with nil locale")
+	}
+	if l.Tag == language.Und {
+		return errors.New("strftime: RegisterLocale called with undefined language tag")
+	}
+	loc := *l
+
+	strftimeLocaleLock.Lock()
+	defer strftimeLocaleLock.Unlock()
+
+	if err := checkLocale(&loc); err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, x := range strftimeLocales {
+		if x.Tag == loc.Tag {
+			// replace existing locale, keeping its priority in the matcher
+			strftimeLocales[i] = &loc
+			replaced = true
+		}
+	}
+	if !replaced {
+		strftimeLocales = append(strftimeLocales, &loc)
+	}
+	rebuildLocales()
+	return nil
+}
+
+// LocaleError reports the problems found in a locale by CheckLocale.
+type LocaleError struct {
+	Tag     language.Tag // Tag of the locale
+	Missing []string     // Fields that are empty in the locale and its parents
+	Err     error        // First invalid format found in the locale, if any
+}
+
+// Error returns the string representation of a LocaleError.
+func (e *LocaleError) Error() string {
+	msg := "strftime: locale " + e.Tag.String()
+	if len(e.Missing) > 0 {
+		msg += " is missing " + strings.Join(e.Missing, ", ")
+		if e.Err != nil {
+			msg += " and"
+		}
+	}
+	if e.Err != nil {
+		msg += " has an invalid format: " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the format error, if any.
+func (e *LocaleError) Unwrap() error {
+	return e.Err
+}
+
+// CheckLocale reports whether locale l is complete, taking into account the fields
+// inherited from the registered parent locales of l.Tag, but not those of the root
+// locale. A complete locale has date and time formats, 12-hour time format, AM/PM
+// indicators and all day and month names. The formats must also be valid, and must not
+// refer to themselves through composite conversions (such as a DTfmt containing %c).
+//
+// RegisterLocale runs the same check.
+//
+// Parameters:
+//   - l: Locale information to check
+//
+// Returns: nil if the locale is complete, or a *LocaleError listing the problems
+func CheckLocale(l *Locale) error {
+	strftimeLocaleLock.RLock()
+	defer strftimeLocaleLock.RUnlock()
+
+	return checkLocale(l)
+}
+
+// checkLocale implements CheckLocale. The caller must hold strftimeLocaleLock.
+func checkLocale(l *Locale) error {
+	defined := make(map[language.Tag]*Locale, len(strftimeLocales))
+	for _, loc := range strftimeLocales {
+		defined[loc.Tag] = loc
+	}
+	res := resolveLocale(l, defined)
+
+	e := &LocaleError{Tag: l.Tag}
+	for _, f := range [...]struct {
+		name, value string
+	}{
+		{"DTfmt", res.DTfmt}, {"Dfmt", res.Dfmt}, {"Tfmt", res.Tfmt}, {"Tfmt12", res.Tfmt12},
+		{"AmPm[0]", res.AmPm[0]}, {"AmPm[1]", res.AmPm[1]},
+	} {
+		if f.value == "" {
+			e.Missing = append(e.Missing, f.name)
+		}
+	}
+	for _, names := range [...]struct {
+		name string
+		list []string
+	}{
+		{"AbDay", res.AbDay[:]}, {"Day", res.Day[:]}, {"AbMonth", res.AbMonth[:]}, {"Month", res.Month[:]},
+	} {
+		for i, v := range names.list {
+			if v == "" {
+				e.Missing = append(e.Missing, names.name+"["+strconv.Itoa(i)+"]")
+			}
+		}
+	}
+
+	if err := compositeCycle(res); err != nil {
+		// formats referring to themselves cannot be expanded
+		e.Err = err
+		return e
+	}
+	fm := newFormatter(res)
+	for _, f := range [...]string{res.DTfmt, res.Dfmt, res.Tfmt, res.Tfmt12, res.DfmtShort, res.TfmtShort, res.DTfmtEra, res.DfmtEra, res.TfmtEra} {
+		if err := fm.validate(f); err != nil {
+			e.Err = err
+			break
+		}
+	}
+
+	if len(e.Missing) > 0 || e.Err != nil {
+		return e
+	}
+	return nil
+}
+
+// compositeCycle returns a *FormatError for the first composite conversion (such as %c
+// or %x) of locale l whose format refers back to it, directly or through other composite
+// conversions, as in a DTfmt containing %c, or nil if there is none.
+func compositeCycle(l *Locale) error {
+	var visit func(f string, active map[string]bool) error
+	visit = func(f string, active map[string]bool) error {
+		var err error
+		scanFormat(f, func(pos, conv, end int) bool {
+			key := f[conv-1 : conv+1]
+			if key[0] != 'E' {
+				key = key[1:]
+			}
+			switch key {
+			case "c", "x", "X", "D", "r", "R", "Ec", "Ex", "EX":
+			default:
+				return true
+			}
+			if active[key] {
+				err = formatError(f, pos, conv-pos, "composite conversion refers to itself")
+				return false
+			}
+			var mod byte
+			if len(key) == 2 {
+				mod = 'E'
+			}
+			active[key] = true
+			err = visit(compositeFormat(l, mod, key[len(key)-1]), active)
+			delete(active, key)
+			return err == nil
+		})
+		return err
+	}
+	return visit("%c%x%X%D%r%R%Ec%Ex%EX", map[string]bool{})
+}
+
+// resolveLocale returns a copy of locale l with its empty fields inherited from the
+// locales defined for the parents of its tag.
+func resolveLocale(l *Locale, defined map[language.Tag]*Locale) *Locale {
+	res := *l
+	for tag := l.Tag.Parent(); tag != language.Und; tag = tag.Parent() {
+		if parent, ok := defined[tag]; ok {
+			inheritLocale(&res, parent)
+		}
+	}
+	// standalone month names are the same as in dates unless specified
+	inheritNames(res.AbAltMonth[:], res.AbMonth[:])
+	inheritNames(res.AltMonth[:], res.Month[:])
+	return &res
+}
+
+// inheritLocale fills the empty fields of locale l with the values of parent.
+func inheritLocale(l, parent *Locale) {
+	for _, f := range [...]struct{ dst, src *string }{
+		{&l.DTfmt, &parent.DTfmt}, {&l.Dfmt, &parent.Dfmt}, {&l.Tfmt, &parent.Tfmt}, {&l.Tfmt12, &parent.Tfmt12},
+		{&l.DfmtShort, &parent.DfmtShort}, {&l.TfmtShort, &parent.TfmtShort},
+		{&l.DTfmtEra, &parent.DTfmtEra}, {&l.DfmtEra, &parent.DfmtEra}, {&l.TfmtEra, &parent.TfmtEra},
+	} {
+		if *f.dst == "" {
+			*f.dst = *f.src
+		}
+	}
+	if l.AmPm[0] == "" && l.AmPm[1] == "" {
+		l.AmPm = parent.AmPm
+	}
+	inheritNames(l.AbDay[:], parent.AbDay[:])
+	inheritNames(l.Day[:], parent.Day[:])
+	inheritNames(l.AbMonth[:], parent.AbMonth[:])
+	inheritNames(l.Month[:], parent.Month[:])
+	inheritNames(l.AbAltMonth[:], parent.AbAltMonth[:])
+	inheritNames(l.AltMonth[:], parent.AltMonth[:])
+	if l.Oprint == nil {
+		l.Oprint = parent.Oprint
+	}
+	if l.Numbering == "" {
+		l.Numbering = parent.Numbering
+	}
+	if l.Calendar == nil && l.Eyear == nil {
+		l.Calendar, l.Eyear = parent.Calendar, parent.Eyear
+	}
+}
+
+// inheritNames fills the empty names of list with the ones of parent.
+func inheritNames(list, parent []string) {
+	for i, v := range list {
+		if v == "" {
+			list[i] = parent[i]
+		}
+	}
+}
+
+// LookupLocale returns a copy of the locale registered for tag l, or of the closest
+// match if there is none. The copy can be modified and passed to RegisterLocale, for
+// example to patch names in one of the built-in locales.
+//
+// Parameters:
+//   - l: Language tag of the locale to look up
+//
+// Returns: A copy of the matching locale information
+func LookupLocale(l language.Tag) *Locale {
+	loc := *lookupLocale(l)
+	return &loc
+}
+
+// lookupLocale returns the locale registered for tag l, or the closest match.
+func lookupLocale(l language.Tag) *Locale {
+	strftimeLocaleLock.RLock()
+	defer strftimeLocaleLock.RUnlock()
+
+	locale, ok := strftimeLocaleTable[l]
+	if !ok {
+		locale, ok = regionalLocale(l)
+	}
+	if !ok {
+		// need to match locale
+		_, i, _ := strftimeLocaleMatcher.Match(l)
+		locale = strftimeResolved[i]
+	}
+	return locale
+}
+
+// rootLocale provides the values of the fields that are not defined by a locale or its
+// parents: the POSIX C locale formats and English names.
+var rootLocale = &Locale{
+	Tag:       language.Und,
+	DTfmt:     "%a %b %e %H:%M:%S %Y",
+	Dfmt:      "%m/%d/%y",
+	Tfmt:      "%H:%M:%S",
+	Tfmt12:    "%I:%M:%S %p",
+	DfmtShort: "%m/%d/%y",
+	TfmtShort: "%H:%M",
+	AmPm:      [2]string{"AM", "PM"},
+
+	AbDay:   englishLocale.AbDay,
+	Day:     englishLocale.Day,
+	AbMonth: englishLocale.AbMonth,
+	Month:   englishLocale.Month,
+
+	AbAltMonth: englishLocale.AbMonth,
+	AltMonth:   englishLocale.Month,
+}
+
+// strftimeLocales contains all supported locales with their formatting information, as
+// defined (before inheritance from parent locales)
+var strftimeLocales = []*Locale{
 	englishLocale,
 	americanEnglishLocale,
 	britishEnglishLocale,
-	&strftimeLocaleInfo{
-		tag:   language.Spanish,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d/%m/%y",
-		Tfmt:  "%T",
+	internationalEnglishLocale,
+	australianEnglishLocale,
+	canadianEnglishLocale,
+	irishEnglishLocale,
+	indianEnglishLocale,
+	southAfricanEnglishLocale,
+	&Locale{
+		Tag:    language.Spanish,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d/%m/%y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %P",
+		AmPm:   [2]string{"a. m.", "p. m."},
+
+		DfmtShort: "%d/%m/%y",
 
 		AbDay:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
 		Day:     [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
 		AbMonth: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
 		Month:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.German,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d.%m.%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:  language.MustParse("es-419"),
+		AmPm: [2]string{"a.m.", "p.m."},
+	},
+	&Locale{
+		Tag:  language.MustParse("es-AR"),
+		Dfmt: "%d/%m/%Y",
+	},
+	&Locale{
+		Tag:  language.MustParse("es-MX"),
+		Dfmt: "%d/%m/%Y",
+	},
+	&Locale{
+		Tag:    language.German,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d.%m.%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d.%m.%y",
 
 		AbDay:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
 		Day:     [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
 		AbMonth: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
 		Month:   [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.French,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d/%m/%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:     language.MustParse("de-AT"),
+		AbMonth: [12]string{"Jän", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		Month:   [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	},
+	&Locale{
+		Tag:  language.MustParse("de-CH"),
+		Dfmt: "%d.%m.%Y",
+	},
+	&Locale{
+		Tag:    language.French,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d/%m/%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d/%m/%y",
 
 		AbDay:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
 		Day:     [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
 		AbMonth: [12]string{"janv.", "févr.", "mars", "avril", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
 		Month:   [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.Italian,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d/%m/%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:       language.MustParse("fr-BE"),
+		Dfmt:      "%d/%m/%y",
+		DfmtShort: "%d/%m/%y",
+	},
+	&Locale{
+		Tag:       language.CanadianFrench,
+		Dfmt:      "%Y-%m-%d",
+		Tfmt12:    "%I:%M:%S %P",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		DfmtShort: "%y-%m-%d",
+	},
+	&Locale{
+		Tag:       language.MustParse("fr-CH"),
+		Dfmt:      "%d.%m.%Y",
+		DfmtShort: "%d.%m.%y",
+	},
+	&Locale{
+		Tag:    language.Italian,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d/%m/%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d/%m/%y",
 
 		AbDay:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
 		Day:     [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
 		AbMonth: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
 		Month:   [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.Dutch,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d-%m-%y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:       language.MustParse("it-CH"),
+		Dfmt:      "%d.%m.%Y",
+		DfmtShort: "%d.%m.%y",
+	},
+	&Locale{
+		Tag:    language.Dutch,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d-%m-%y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %P",
+		AmPm:   [2]string{"a.m.", "p.m."},
+
+		DfmtShort: "%d-%m-%y",
 
 		AbDay:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
 		Day:     [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
 		AbMonth: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
 		Month:   [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.Polish,
-		DTfmt: "%a, %-d %b %Y, %T",
-		Dfmt:  "%d.%m.%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:       language.MustParse("nl-BE"),
+		Dfmt:      "%d/%m/%Y",
+		DfmtShort: "%d/%m/%y",
+	},
+	&Locale{
+		Tag:    language.Polish,
+		DTfmt:  "%a, %-d %b %Y, %T",
+		Dfmt:   "%d.%m.%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d.%m.%y",
 
 		AbDay:   [7]string{"nie", "pon", "wto", "śro", "czw", "pią", "sob"},
 		Day:     [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
 		AbMonth: [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
-		Month:   [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		Month:   [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+
+		AltMonth: [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.Portuguese,
-		DTfmt: "%a %d %b %Y %T %Z",
-		Dfmt:  "%d-%m-%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:    language.Portuguese,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d-%m-%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d-%m-%y",
 
 		AbDay:   [7]string{"Dom", "Seg", "Ter", "Qua", "Qui", "Sex", "Sáb"},
 		Day:     [7]string{"Domingo", "Segunda", "Terça", "Quarta", "Quinta", "Sexta", "Sábado"},
 		AbMonth: [12]string{"Jan", "Fev", "Mar", "Abr", "Mai", "Jun", "Jul", "Ago", "Set", "Out", "Nov", "Dez"},
 		Month:   [12]string{"Janeiro", "Fevereiro", "Março", "Abril", "Maio", "Junho", "Julho", "Agosto", "Setembro", "Outubro", "Novembro", "Dezembro"},
 	},
-	&strftimeLocaleInfo{
-		tag:   language.Russian,
-		DTfmt: "%a %d %b %Y %T",
-		Dfmt:  "%d.%m.%Y",
-		Tfmt:  "%T",
+	&Locale{
+		Tag:       language.BrazilianPortuguese,
+		Dfmt:      "%d/%m/%Y",
+		DfmtShort: "%d/%m/%y",
+	},
+	&Locale{
+		Tag:    language.Russian,
+		DTfmt:  "%a %d %b %Y %T",
+		Dfmt:   "%d.%m.%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"AM", "PM"},
+
+		DfmtShort: "%d.%m.%y",
 
 		AbDay:   [7]string{"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
 		Day:     [7]string{"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота"},
-		AbMonth: [12]string{"янв", "фев", "мар", "апр", "май", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
-		Month:   [12]string{"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
-	},
-	&strftimeLocaleInfo{
-		tag:      language.Thai,
-		DTfmt:    "%a %e %b %Ey, %H:%M:%S",
-		Dfmt:     "%d/%m/%Ey",
-		Tfmt:     "%H:%M:%S",
-		Tfmt12:   "%I:%M:%S %p",
-		DTfmtEra: "วัน%Aที่ %e %B %EC %Ey, %H.%M.%S น.",
-		DfmtEra:  "%e %b %Ey",
-		TfmtEra:  "%H.%M.%S น.",
-		AmPm:     [2]string{"AM", "PM"},
-		// TODO: thai era handling
-
-		AbDay:   [7]string{"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."},
-		Day:     [7]string{"อาทิตย์", "จันทร์", "อังคาร", "พุธ", "พฤหัสบดี", "ศุกร์", "เสาร์"},
-		AbMonth: [12]string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."},
-		Month:   [12]string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"},
-	},
-	&strftimeLocaleInfo{
-		tag:    language.Korean,
+		AbMonth: [12]string{"янв", "фев", "мар", "апр", "мая", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
+		Month:   [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+
+		AbAltMonth: [12]string{"янв", "фев", "мар", "апр", "май", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
+		AltMonth:   [12]string{"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+	},
+	&Locale{
+		Tag:    language.Ukrainian,
+		DTfmt:  "%a %d %b %Y %T",
+		Dfmt:   "%d.%m.%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"дп", "пп"},
+
+		DfmtShort: "%d.%m.%y",
+
+		AbDay:   [7]string{"нд", "пн", "вт", "ср", "чт", "пт", "сб"},
+		Day:     [7]string{"неділя", "понеділок", "вівторок", "середа", "четвер", "пʼятниця", "субота"},
+		AbMonth: [12]string{"січ", "лют", "бер", "кві", "тра", "чер", "лип", "сер", "вер", "жов", "лис", "гру"},
+		Month:   [12]string{"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
+
+		AltMonth: [12]string{"січень", "лютий", "березень", "квітень", "травень", "червень", "липень", "серпень", "вересень", "жовтень", "листопад", "грудень"},
+	},
+	&Locale{
+		Tag:    language.Czech,
+		DTfmt:  "%a %d. %b %Y, %T",
+		Dfmt:   "%d.%m.%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"dop.", "odp."},
+
+		DfmtShort: "%d.%m.%y",
+
+		AbDay:   [7]string{"ne", "po", "út", "st", "čt", "pá", "so"},
+		Day:     [7]string{"neděle", "pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota"},
+		AbMonth: [12]string{"led", "úno", "bře", "dub", "kvě", "čvn", "čvc", "srp", "zář", "říj", "lis", "pro"},
+		Month:   [12]string{"ledna", "února", "března", "dubna", "května", "června", "července", "srpna", "září", "října", "listopadu", "prosince"},
+
+		AltMonth: [12]string{"leden", "únor", "březen", "duben", "květen", "červen", "červenec", "srpen", "září", "říjen", "listopad", "prosinec"},
+	},
+	&Locale{
+		Tag:    language.Greek,
+		DTfmt:  "%a %d %b %Y %r %Z",
+		Dfmt:   "%d/%m/%Y",
+		Tfmt:   "%r",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"π.μ.", "μ.μ."},
+
+		DfmtShort: "%d/%m/%y",
+
+		AbDay:   [7]string{"Κυρ", "Δευ", "Τρι", "Τετ", "Πεμ", "Παρ", "Σαβ"},
+		Day:     [7]string{"Κυριακή", "Δευτέρα", "Τρίτη", "Τετάρτη", "Πέμπτη", "Παρασκευή", "Σάββατο"},
+		AbMonth: [12]string{"Ιαν", "Φεβ", "Μαρ", "Απρ", "Μαΐ", "Ιουν", "Ιουλ", "Αυγ", "Σεπ", "Οκτ", "Νοε", "Δεκ"},
+		Month:   [12]string{"Ιανουαρίου", "Φεβρουαρίου", "Μαρτίου", "Απριλίου", "Μαΐου", "Ιουνίου", "Ιουλίου", "Αυγούστου", "Σεπτεμβρίου", "Οκτωβρίου", "Νοεμβρίου", "Δεκεμβρίου"},
+
+		AbAltMonth: [12]string{"Ιαν", "Φεβ", "Μάρ", "Απρ", "Μάι", "Ιούν", "Ιούλ", "Αύγ", "Σεπ", "Οκτ", "Νοέ", "Δεκ"},
+		AltMonth:   [12]string{"Ιανουάριος", "Φεβρουάριος", "Μάρτιος", "Απρίλιος", "Μάιος", "Ιούνιος", "Ιούλιος", "Αύγουστος", "Σεπτέμβριος", "Οκτώβριος", "Νοέμβριος", "Δεκέμβριος"},
+	},
+	&Locale{
+		Tag:    language.Lithuanian,
+		DTfmt:  "%Y m. %B %d d. %T",
+		Dfmt:   "%Y-%m-%d",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"priešpiet", "popiet"},
+
+		DfmtShort: "%y-%m-%d",
+
+		AbDay:   [7]string{"Sk", "Pr", "An", "Tr", "Kt", "Pn", "Št"},
+		Day:     [7]string{"sekmadienis", "pirmadienis", "antradienis", "trečiadienis", "ketvirtadienis", "penktadienis", "šeštadienis"},
+		AbMonth: [12]string{"sau", "vas", "kov", "bal", "geg", "bir", "lie", "rgp", "rgs", "spa", "lap", "grd"},
+		Month:   [12]string{"sausio", "vasario", "kovo", "balandžio", "gegužės", "birželio", "liepos", "rugpjūčio", "rugsėjo", "spalio", "lapkričio", "gruodžio"},
+
+		AltMonth: [12]string{"sausis", "vasaris", "kovas", "balandis", "gegužė", "birželis", "liepa", "rugpjūtis", "rugsėjis", "spalis", "lapkritis", "gruodis"},
+	},
+	&Locale{
+		Tag:    language.Catalan,
+		DTfmt:  "%a %d %b %Y %T %Z",
+		Dfmt:   "%d/%m/%Y",
+		Tfmt:   "%T",
+		Tfmt12: "%I:%M:%S %P",
+		AmPm:   [2]string{"a. m.", "p. m."},
+
+		DfmtShort: "%d/%m/%y",
+
+		AbDay:   [7]string{"dg.", "dl.", "dt.", "dc.", "dj.", "dv.", "ds."},
+		Day:     [7]string{"diumenge", "dilluns", "dimarts", "dimecres", "dijous", "divendres", "dissabte"},
+		AbMonth: [12]string{"de gen.", "de febr.", "de març", "d’abr.", "de maig", "de juny", "de jul.", "d’ag.", "de set.", "d’oct.", "de nov.", "de des."},
+		Month:   [12]string{"de gener", "de febrer", "de març", "d’abril", "de maig", "de juny", "de juliol", "d’agost", "de setembre", "d’octubre", "de novembre", "de desembre"},
+
+		AbAltMonth: [12]string{"gen.", "febr.", "març", "abr.", "maig", "juny", "jul.", "ag.", "set.", "oct.", "nov.", "des."},
+		AltMonth:   [12]string{"gener", "febrer", "març", "abril", "maig", "juny", "juliol", "agost", "setembre", "octubre", "novembre", "desembre"},
+	},
+	arabicLocale,
+	saudiArabicLocale,
+	persianLocale,
+	afghanPersianLocale,
+	hebrewLocale,
+	thaiLocale,
+	&Locale{
+		Tag:    language.Korean,
 		DTfmt:  "%x (%a) %r",
 		Dfmt:   "%Y년 %m월 %d일",
 		Tfmt:   "%H시 %M분 %S초",
 		Tfmt12: "%p %I시 %M분 %S초",
 		AmPm:   [2]string{"오전", "오후"},
 
+		DfmtShort: "%y. %m. %d.",
+		TfmtShort: "%H시 %M분",
+
 		AbDay:   [7]string{"일", "월", "화", "수", "목", "금", "토"},
 		Day:     [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
 		AbMonth: [12]string{" 1월", " 2월", " 3월", " 4월", " 5월", " 6월", " 7월", " 8월", " 9월", "10월", "11월", "12월"},
@@ -186,4 +701,6 @@ var strftimeLocales = [...]*strftimeLocaleInfo{
 	japaneseLocale,
 	simplifiedChineseLocale,
 	traditionalChineseLocale,
+	taiwaneseChineseLocale,
+	hongKongChineseLocale,
 }
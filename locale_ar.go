@@ -0,0 +1,408 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	// arabicLocale defines the Arabic locale information for formatting dates and times.
+	// Normal formats use the Gregorian calendar with ASCII digits, while era formats
+	// (%Ec, %Ex and %EX) use Arabic-Indic digits, and the Hijri calendar when one is
+	// selected (as in ar-u-ca-islamic).
+	arabicLocale = &Locale{
+		Tag:       language.Arabic,
+		DTfmt:     "%A %e %B %Y %T",                 // Example: "الاثنين  2 يناير 2006 22:04:05"
+		Dfmt:      "%d/%m/%Y",                       // Example: "02/01/2006"
+		Tfmt:      "%T",                             // Example: "22:04:05"
+		Tfmt12:    "%I:%M:%S %p",                    // Example: "10:04:05 م"
+		DfmtShort: "%d/%m/%y",                       // Example: "02/01/06"
+		DTfmtEra:  "%A %-OEd %EB %OEY، %OH:%OM:%OS", // Example: "الاثنين ١٥ رمضان ١٤٤٥ هـ، ٢٢:٠٤:٠٥"
+		DfmtEra:   "%-OEd %EB %OEY",                 // Example: "١٥ رمضان ١٤٤٥ هـ"
+		TfmtEra:   "%OH:%OM:%OS",                    // Example: "٢٢:٠٤:٠٥"
+		AmPm:      [2]string{"ص", "م"},              // AM/PM indicators (صباحًا and مساءً)
+		Numbering: "arab",                           // Arabic-Indic digits for %O, e.g. "٠٢"
+
+		AbDay:   [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth: [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	}
+
+	// saudiArabicLocale defines the Arabic locale as used in Saudi Arabia, where era
+	// formats use the official Umm al-Qura calendar. The rest is inherited from
+	// arabicLocale.
+	saudiArabicLocale = &Locale{
+		Tag:      language.MustParse("ar-SA"),
+		Calendar: ummAlQuraCalendar{}, // Umm al-Qura Hijri calendar
+	}
+)
+
+// hijriMonths holds the Hijri month names in Arabic and English, full and abbreviated.
+var hijriMonths = struct {
+	arabic, english, abEnglish [12]string
+}{
+	arabic:    [12]string{"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة", "رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"},
+	english:   [12]string{"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II", "Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah"},
+	abEnglish: [12]string{"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II", "Raj.", "Sha.", "Ram.", "Shaw.", "Dhuʻl-Q.", "Dhuʻl-H."},
+}
+
+// arabicBase is the base language of Arabic locales, which use Arabic names for the
+// Hijri months and era.
+var arabicBase, _ = language.Arabic.Base()
+
+// hijriDate returns the Hijri date of year y (counted from the Hijra, as 1 for the first
+// year), month and day, with years before the Hijra in era 1.
+func hijriDate(t time.Time, y, m, d int) CalendarDate {
+	res := CalendarDate{Time: t, Year: y, Month: m, Day: d}
+	if y < 1 {
+		res.Era, res.Year = 1, 1-y
+	}
+	return res
+}
+
+// appendHijriEra appends the era name ('C'), the year ('y', 'r' and 'k'), or both ('Y')
+// of Hijri date d, in Arabic (هـ) or English (AH).
+func appendHijriEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	era := [2]string{"AH", "BH"}
+	if base, _ := tag.Base(); base == arabicBase {
+		era = [2]string{"هـ", "ق.هـ"}
+	}
+
+	switch c {
+	case 'C':
+		return append(b, era[d.Era]...), true // Return just the era name
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true // Return just the year within era
+	case 'Y':
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(append(b, ' '), era[d.Era]...), true
+	}
+	return b, false
+}
+
+// hijriMonthName returns the name of the month of Hijri date d, in Arabic or English.
+func hijriMonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	if base, _ := tag.Base(); base == arabicBase {
+		return hijriMonths.arabic[d.Month-1]
+	}
+	if abbrev {
+		return hijriMonths.abEnglish[d.Month-1]
+	}
+	return hijriMonths.english[d.Month-1]
+}
+
+// tabularIslamicCalendar is the arithmetic (tabular) Islamic calendar, in which months
+// alternately have 30 and 29 days, and the last month has 30 days in 11 years of every
+// 30 years cycle (2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29). epoch is the Julian day
+// number of the first day of the calendar: July 16th, 622 (Julian) for the civil
+// variant (islamic-civil), or the day before for the astronomical one (islamic-tbla).
+type tabularIslamicCalendar struct {
+	epoch int
+}
+
+// Date returns the Hijri date of t.
+func (c tabularIslamicCalendar) Date(t time.Time) CalendarDate {
+	y, m, d := c.fromDayNumber(dayNumber(t.Date()))
+	return hijriDate(t, y, m, d)
+}
+
+// fromDayNumber returns the Hijri year, month and day of Julian day number jdn.
+func (c tabularIslamicCalendar) fromDayNumber(jdn int) (year, month, day int) {
+	n := jdn - c.epoch
+	year = floorDiv(30*n+10646, 10631)
+	days := n - hijriYearStart(year)
+	month = min(2*days/59, 11) // months alternate between 30 and 29 days
+	return year, month + 1, days - (59*month+1)/2 + 1
+}
+
+// hijriYearStart returns the number of days between the start of the tabular Islamic
+// calendar and the first day of year y.
+func hijriYearStart(y int) int {
+	return (y-1)*354 + floorDiv(3+11*y, 30)
+}
+
+// floorDiv returns a/b rounded towards negative infinity, for b > 0.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// AppendEra appends the era name or year.
+func (tabularIslamicCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	return appendHijriEra(b, tag, d, c)
+}
+
+// MonthName returns the name of the Hijri month.
+func (tabularIslamicCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return hijriMonthName(tag, d, abbrev)
+}
+
+var (
+	islamicCivilCalendar = tabularIslamicCalendar{epoch: 1948440}
+	islamicTblaCalendar  = tabularIslamicCalendar{epoch: 1948439}
+)
+
+// ummAlQuraCalendar is the Umm al-Qura calendar, the official Hijri calendar of Saudi
+// Arabia. Months of the years 1356 to 1500 AH (1937 to 2077) come from the tables
+// published by the King Abdulaziz City for Science and Technology. Other months from
+// 1300 to 1600 AH are computed with the rule used for the tables since 1420 AH (1999):
+// a month starts on the day after the 29th of the previous month if, seen from Mecca,
+// the new moon occurs before sunset and the Moon sets after the Sun on that day;
+// otherwise the previous month has 30 days. Dates outside of the years 1300 to 1600 AH
+// use the civil tabular calendar.
+type ummAlQuraCalendar struct{}
+
+// Location of the Kaaba in Mecca, for the Umm al-Qura rule
+const meccaLat, meccaLon = 21.4225, 39.8262
+
+// hijriLunationOffset is the number of months between the first month of the Hijri
+// calendar and lunation 0 (see newMoonK).
+const hijriLunationOffset = 17037
+
+// ummAlQuraFirstMonth is the number of months between the first month of the Hijri
+// calendar and the first month of ummAlQuraStarts, Muharram 1356.
+const ummAlQuraFirstMonth = (1356 - 1) * 12
+
+// ummAlQuraEpoch is the Julian day number of 1 Muharram 1356 (March 14th, 1937).
+const ummAlQuraEpoch = 2428607
+
+// ummAlQuraCacheMonths is the range of months cached by ummAlQuraMonthStart, from
+// Muharram 1299 to Dhu al-Hijjah 1601, around the range of the calendar.
+const ummAlQuraCacheMonths = (1602 - 1299) * 12
+
+var (
+	// ummAlQuraCacheLock protects ummAlQuraCache
+	ummAlQuraCacheLock sync.RWMutex
+
+	// ummAlQuraCache holds the Julian day number of the first day of the months computed
+	// by ummAlQuraMonthStart since Muharram 1299, or 0 for those not computed yet
+	ummAlQuraCache [ummAlQuraCacheMonths]int32
+)
+
+// ummAlQuraMonthStart returns the Julian day number of the first day of the month that
+// starts after the new moon of lunation k.
+func ummAlQuraMonthStart(k int) int {
+	n := k + hijriLunationOffset
+	if i := n - ummAlQuraFirstMonth; i >= 0 && i < len(ummAlQuraStarts) {
+		return ummAlQuraEpoch + int(ummAlQuraStarts[i])
+	}
+	i := n - (1299-1)*12
+	cached := i >= 0 && i < ummAlQuraCacheMonths
+	if cached {
+		ummAlQuraCacheLock.RLock()
+		start := ummAlQuraCache[i]
+		ummAlQuraCacheLock.RUnlock()
+		if start != 0 {
+			return int(start)
+		}
+	}
+
+	// day of the new moon, in Mecca time (UTC+3)
+	nm := newMoon(k)
+	day := int(nm + 0.5 + 3.0/24)
+	res := day + 2
+	ss := sunset(float64(day)-0.5-3.0/24, meccaLat, meccaLon)
+	if nm < ss && moonAboveHorizon(ss, meccaLat, meccaLon) {
+		res = day + 1
+	}
+
+	if cached {
+		ummAlQuraCacheLock.Lock()
+		ummAlQuraCache[i] = int32(res)
+		ummAlQuraCacheLock.Unlock()
+	}
+	return res
+}
+
+// Date returns the Hijri date of t.
+func (ummAlQuraCalendar) Date(t time.Time) CalendarDate {
+	jdn := dayNumber(t.Date())
+	if y, _, _ := islamicCivilCalendar.fromDayNumber(jdn); y < 1299 || y > 1601 {
+		// far outside of the range of the calendar
+		return islamicCivilCalendar.Date(t)
+	}
+	k := int(float64(jdn-2451550) / 29.530588861)
+	for ummAlQuraMonthStart(k) > jdn {
+		k--
+	}
+	for ummAlQuraMonthStart(k+1) <= jdn {
+		k++
+	}
+	n := k + hijriLunationOffset
+	y := floorDiv(n, 12) + 1
+	if y < 1300 || y > 1600 {
+		return islamicCivilCalendar.Date(t)
+	}
+	return hijriDate(t, y, n-(y-1)*12+1, jdn-ummAlQuraMonthStart(k)+1)
+}
+
+// AppendEra appends the era name or year.
+func (ummAlQuraCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	return appendHijriEra(b, tag, d, c)
+}
+
+// MonthName returns the name of the Hijri month.
+func (ummAlQuraCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return hijriMonthName(tag, d, abbrev)
+}
+
+// ummAlQuraStarts holds the first day of the months of the Umm al-Qura calendar from
+// Muharram 1356 to Muharram 1501, in days since ummAlQuraEpoch, as published by the King
+// Abdulaziz City for Science and Technology (compiled by R.H. van Gent). Shaʻban 1364
+// has 28 days in the published table.
+var ummAlQuraStarts = [...]uint16{
+	0, 29, 58, 88, 117, 147, 176, 206, 236, 265, 294, 324, // 1356
+	353, 383, 412, 442, 471, 501, 530, 560, 589, 619, 648, 678, // 1357
+	708, 738, 768, 797, 827, 856, 885, 915, 944, 973, 1003, 1033, // 1358
+	1062, 1092, 1122, 1152, 1181, 1211, 1240, 1269, 1299, 1328, 1357, 1387, // 1359
+	1416, 1446, 1475, 1505, 1534, 1564, 1593, 1623, 1652, 1682, 1711, 1741, // 1360
+	1771, 1801, 1830, 1860, 1889, 1919, 1948, 1978, 2007, 2037, 2066, 2096, // 1361
+	2125, 2155, 2184, 2214, 2243, 2273, 2302, 2332, 2361, 2391, 2420, 2450, // 1362
+	2479, 2509, 2538, 2568, 2597, 2627, 2656, 2686, 2715, 2745, 2774, 2804, // 1363
+	2834, 2864, 2893, 2923, 2952, 2982, 3011, 3041, 3069, 3099, 3129, 3159, // 1364
+	3188, 3218, 3247, 3277, 3306, 3336, 3365, 3395, 3424, 3454, 3483, 3513, // 1365
+	3543, 3573, 3602, 3632, 3661, 3691, 3720, 3750, 3779, 3809, 3838, 3868, // 1366
+	3897, 3927, 3956, 3986, 4015, 4045, 4074, 4104, 4133, 4163, 4192, 4222, // 1367
+	4251, 4281, 4310, 4340, 4369, 4399, 4428, 4458, 4487, 4517, 4546, 4576, // 1368
+	4606, 4636, 4665, 4695, 4724, 4754, 4783, 4813, 4843, 4872, 4902, 4932, // 1369
+	4961, 4991, 5020, 5050, 5079, 5109, 5138, 5168, 5197, 5227, 5256, 5286, // 1370
+	5315, 5345, 5374, 5404, 5433, 5462, 5492, 5521, 5551, 5580, 5610, 5640, // 1371
+	5670, 5699, 5729, 5758, 5788, 5817, 5847, 5876, 5905, 5935, 5964, 5994, // 1372
+	6024, 6053, 6083, 6112, 6142, 6171, 6201, 6230, 6260, 6289, 6319, 6348, // 1373
+	6378, 6408, 6437, 6467, 6496, 6526, 6555, 6585, 6615, 6644, 6673, 6703, // 1374
+	6733, 6763, 6792, 6822, 6851, 6881, 6910, 6940, 6969, 6998, 7028, 7058, // 1375
+	7087, 7116, 7146, 7175, 7204, 7234, 7264, 7294, 7323, 7353, 7382, 7412, // 1376
+	7441, 7471, 7500, 7529, 7559, 7588, 7618, 7647, 7677, 7707, 7736, 7766, // 1377
+	7796, 7826, 7855, 7885, 7914, 7944, 7973, 8003, 8032, 8062, 8091, 8121, // 1378
+	8150, 8179, 8209, 8238, 8268, 8297, 8327, 8356, 8386, 8415, 8445, 8474, // 1379
+	8504, 8534, 8563, 8593, 8622, 8652, 8681, 8711, 8740, 8770, 8799, 8829, // 1380
+	8858, 8888, 8917, 8947, 8977, 9006, 9036, 9065, 9094, 9124, 9153, 9183, // 1381
+	9212, 9242, 9271, 9301, 9331, 9360, 9390, 9420, 9449, 9478, 9508, 9537, // 1382
+	9567, 9596, 9626, 9655, 9685, 9715, 9744, 9774, 9803, 9833, 9862, 9892, // 1383
+	9921, 9951, 9980, 10010, 10039, 10069, 10098, 10128, 10157, 10187, 10216, 10246, // 1384
+	10275, 10305, 10334, 10364, 10394, 10423, 10452, 10482, 10511, 10541, 10571, 10601, // 1385
+	10630, 10660, 10690, 10719, 10748, 10778, 10807, 10837, 10866, 10896, 10925, 10955, // 1386
+	10985, 11014, 11043, 11073, 11102, 11132, 11161, 11191, 11220, 11250, 11279, 11309, // 1387
+	11339, 11368, 11398, 11428, 11457, 11487, 11516, 11546, 11575, 11605, 11634, 11664, // 1388
+	11693, 11723, 11752, 11782, 11811, 11841, 11870, 11900, 11929, 11959, 11988, 12018, // 1389
+	12048, 12078, 12107, 12137, 12166, 12196, 12225, 12255, 12285, 12314, 12344, 12373, // 1390
+	12402, 12432, 12461, 12491, 12520, 12550, 12579, 12609, 12638, 12668, 12697, 12727, // 1391
+	12757, 12786, 12815, 12845, 12874, 12904, 12933, 12963, 12992, 13022, 13051, 13081, // 1392
+	13111, 13141, 13170, 13200, 13229, 13258, 13287, 13317, 13346, 13376, 13405, 13435, // 1393
+	13465, 13495, 13524, 13554, 13583, 13613, 13642, 13672, 13701, 13730, 13760, 13790, // 1394
+	13819, 13849, 13878, 13908, 13938, 13967, 13997, 14026, 14055, 14085, 14114, 14144, // 1395
+	14173, 14203, 14232, 14262, 14292, 14322, 14351, 14381, 14410, 14439, 14469, 14498, // 1396
+	14528, 14557, 14587, 14616, 14646, 14676, 14705, 14735, 14764, 14794, 14823, 14853, // 1397
+	14882, 14912, 14941, 14971, 15000, 15030, 15059, 15089, 15119, 15148, 15178, 15207, // 1398
+	15237, 15266, 15296, 15325, 15355, 15384, 15414, 15443, 15473, 15502, 15532, 15562, // 1399
+	15591, 15621, 15651, 15680, 15710, 15739, 15768, 15798, 15827, 15857, 15886, 15916, // 1400
+	15946, 15975, 16005, 16034, 16064, 16093, 16123, 16152, 16181, 16211, 16240, 16270, // 1401
+	16299, 16329, 16359, 16389, 16418, 16448, 16477, 16507, 16536, 16565, 16595, 16624, // 1402
+	16654, 16683, 16713, 16743, 16773, 16802, 16832, 16861, 16891, 16920, 16949, 16979, // 1403
+	17008, 17037, 17067, 17097, 17126, 17156, 17186, 17216, 17245, 17275, 17304, 17333, // 1404
+	17363, 17392, 17421, 17451, 17481, 17510, 17540, 17570, 17599, 17629, 17658, 17688, // 1405
+	17717, 17747, 17776, 17806, 17835, 17865, 17894, 17924, 17953, 17983, 18013, 18042, // 1406
+	18072, 18101, 18131, 18160, 18190, 18219, 18249, 18278, 18308, 18337, 18367, 18396, // 1407
+	18426, 18456, 18485, 18515, 18544, 18574, 18603, 18633, 18662, 18691, 18721, 18750, // 1408
+	18780, 18810, 18839, 18869, 18899, 18928, 18958, 18987, 19017, 19046, 19075, 19105, // 1409
+	19134, 19164, 19193, 19223, 19253, 19283, 19312, 19342, 19371, 19401, 19430, 19459, // 1410
+	19489, 19518, 19548, 19577, 19607, 19637, 19666, 19696, 19726, 19755, 19785, 19814, // 1411
+	19843, 19873, 19902, 19931, 19961, 19991, 20020, 20050, 20080, 20110, 20139, 20169, // 1412
+	20198, 20227, 20257, 20286, 20315, 20345, 20375, 20404, 20434, 20464, 20493, 20523, // 1413
+	20553, 20582, 20611, 20641, 20670, 20699, 20729, 20758, 20788, 20818, 20848, 20877, // 1414
+	20907, 20936, 20966, 20995, 21025, 21054, 21083, 21113, 21142, 21172, 21202, 21231, // 1415
+	21261, 21291, 21320, 21350, 21379, 21409, 21438, 21468, 21497, 21526, 21556, 21585, // 1416
+	21615, 21645, 21674, 21704, 21733, 21763, 21793, 21822, 21852, 21881, 21911, 21940, // 1417
+	21969, 21999, 22028, 22058, 22087, 22117, 22147, 22177, 22206, 22236, 22265, 22295, // 1418
+	22324, 22353, 22383, 22412, 22442, 22471, 22501, 22531, 22560, 22590, 22620, 22649, // 1419
+	22679, 22708, 22738, 22767, 22796, 22826, 22855, 22885, 22915, 22945, 22975, 23004, // 1420
+	23034, 23063, 23092, 23122, 23151, 23180, 23209, 23239, 23269, 23299, 23329, 23358, // 1421
+	23388, 23418, 23447, 23476, 23506, 23535, 23564, 23593, 23623, 23653, 23683, 23712, // 1422
+	23742, 23772, 23801, 23831, 23860, 23890, 23919, 23948, 23978, 24007, 24037, 24066, // 1423
+	24096, 24126, 24155, 24185, 24215, 24244, 24274, 24303, 24332, 24362, 24391, 24421, // 1424
+	24450, 24480, 24509, 24539, 24569, 24598, 24628, 24657, 24687, 24717, 24746, 24776, // 1425
+	24805, 24834, 24864, 24893, 24923, 24952, 24982, 25012, 25041, 25071, 25101, 25130, // 1426
+	25160, 25189, 25218, 25248, 25277, 25307, 25336, 25366, 25396, 25425, 25455, 25485, // 1427
+	25514, 25544, 25573, 25602, 25632, 25661, 25690, 25720, 25750, 25780, 25809, 25839, // 1428
+	25869, 25898, 25928, 25957, 25986, 26016, 26045, 26074, 26104, 26134, 26163, 26193, // 1429
+	26223, 26252, 26282, 26312, 26341, 26370, 26400, 26429, 26459, 26488, 26518, 26547, // 1430
+	26577, 26606, 26636, 26666, 26695, 26725, 26754, 26784, 26813, 26843, 26872, 26901, // 1431
+	26931, 26960, 26990, 27020, 27050, 27079, 27109, 27138, 27168, 27197, 27227, 27256, // 1432
+	27285, 27315, 27344, 27374, 27404, 27433, 27463, 27493, 27522, 27552, 27581, 27611, // 1433
+	27640, 27669, 27699, 27728, 27758, 27787, 27817, 27847, 27876, 27906, 27936, 27965, // 1434
+	27994, 28024, 28053, 28083, 28112, 28142, 28171, 28201, 28230, 28260, 28290, 28319, // 1435
+	28349, 28378, 28408, 28437, 28467, 28496, 28526, 28555, 28585, 28614, 28644, 28673, // 1436
+	28703, 28733, 28762, 28792, 28822, 28851, 28880, 28910, 28939, 28969, 28998, 29027, // 1437
+	29057, 29087, 29116, 29146, 29176, 29206, 29235, 29264, 29294, 29323, 29352, 29382, // 1438
+	29411, 29441, 29470, 29500, 29530, 29560, 29589, 29619, 29648, 29678, 29707, 29736, // 1439
+	29766, 29795, 29825, 29854, 29884, 29914, 29944, 29973, 30003, 30032, 30062, 30091, // 1440
+	30120, 30150, 30179, 30209, 30238, 30268, 30298, 30327, 30357, 30387, 30416, 30446, // 1441
+	30475, 30504, 30534, 30563, 30593, 30622, 30652, 30681, 30711, 30741, 30770, 30800, // 1442
+	30829, 30859, 30888, 30918, 30947, 30977, 31006, 31036, 31065, 31095, 31124, 31154, // 1443
+	31184, 31213, 31243, 31272, 31302, 31332, 31361, 31390, 31420, 31449, 31479, 31508, // 1444
+	31538, 31567, 31597, 31627, 31657, 31686, 31716, 31745, 31774, 31804, 31833, 31862, // 1445
+	31892, 31921, 31951, 31981, 32011, 32040, 32070, 32100, 32129, 32158, 32188, 32217, // 1446
+	32246, 32276, 32305, 32335, 32365, 32395, 32424, 32454, 32483, 32513, 32542, 32572, // 1447
+	32601, 32630, 32660, 32689, 32719, 32749, 32778, 32808, 32838, 32867, 32897, 32926, // 1448
+	32956, 32985, 33014, 33044, 33073, 33103, 33132, 33162, 33192, 33221, 33251, 33281, // 1449
+	33310, 33340, 33369, 33399, 33428, 33457, 33487, 33516, 33546, 33575, 33605, 33635, // 1450
+	33664, 33694, 33724, 33753, 33783, 33812, 33841, 33871, 33900, 33930, 33959, 33989, // 1451
+	34018, 34048, 34078, 34108, 34137, 34167, 34196, 34225, 34255, 34284, 34314, 34343, // 1452
+	34373, 34402, 34432, 34462, 34492, 34521, 34550, 34580, 34609, 34639, 34668, 34698, // 1453
+	34727, 34756, 34786, 34816, 34846, 34875, 34905, 34934, 34964, 34993, 35023, 35052, // 1454
+	35082, 35111, 35140, 35170, 35200, 35229, 35259, 35288, 35318, 35348, 35377, 35407, // 1455
+	35436, 35466, 35495, 35524, 35554, 35583, 35613, 35642, 35672, 35702, 35732, 35761, // 1456
+	35791, 35820, 35850, 35879, 35908, 35938, 35967, 35996, 36026, 36056, 36085, 36115, // 1457
+	36145, 36175, 36204, 36234, 36263, 36292, 36322, 36351, 36380, 36410, 36440, 36469, // 1458
+	36499, 36529, 36559, 36588, 36618, 36647, 36676, 36706, 36735, 36764, 36794, 36824, // 1459
+	36853, 36883, 36913, 36942, 36972, 37001, 37031, 37060, 37090, 37119, 37148, 37178, // 1460
+	37208, 37237, 37267, 37296, 37326, 37356, 37385, 37415, 37444, 37474, 37503, 37533, // 1461
+	37562, 37592, 37621, 37651, 37680, 37710, 37739, 37769, 37798, 37828, 37858, 37887, // 1462
+	37917, 37946, 37976, 38005, 38034, 38064, 38093, 38123, 38153, 38182, 38212, 38242, // 1463
+	38271, 38301, 38330, 38360, 38389, 38418, 38448, 38477, 38507, 38536, 38566, 38596, // 1464
+	38626, 38655, 38685, 38714, 38744, 38773, 38802, 38832, 38861, 38890, 38920, 38950, // 1465
+	38980, 39010, 39039, 39069, 39098, 39128, 39157, 39186, 39216, 39245, 39275, 39304, // 1466
+	39334, 39364, 39393, 39423, 39453, 39482, 39512, 39541, 39570, 39600, 39629, 39659, // 1467
+	39688, 39718, 39747, 39777, 39807, 39836, 39866, 39895, 39925, 39954, 39984, 40013, // 1468
+	40043, 40072, 40101, 40131, 40161, 40190, 40220, 40250, 40279, 40309, 40339, 40368, // 1469
+	40397, 40427, 40456, 40485, 40515, 40545, 40574, 40604, 40633, 40663, 40693, 40723, // 1470
+	40752, 40781, 40811, 40840, 40869, 40899, 40928, 40958, 40988, 41017, 41047, 41077, // 1471
+	41106, 41136, 41165, 41195, 41224, 41254, 41283, 41312, 41342, 41371, 41401, 41431, // 1472
+	41460, 41490, 41519, 41549, 41579, 41608, 41638, 41667, 41696, 41726, 41755, 41785, // 1473
+	41814, 41844, 41874, 41903, 41933, 41963, 41992, 42022, 42051, 42080, 42110, 42139, // 1474
+	42169, 42198, 42228, 42257, 42287, 42317, 42347, 42376, 42406, 42435, 42464, 42494, // 1475
+	42523, 42552, 42582, 42611, 42641, 42671, 42701, 42730, 42760, 42790, 42819, 42848, // 1476
+	42878, 42907, 42936, 42966, 42995, 43025, 43055, 43084, 43114, 43144, 43174, 43203, // 1477
+	43232, 43262, 43291, 43320, 43350, 43379, 43409, 43439, 43468, 43498, 43528, 43557, // 1478
+	43587, 43616, 43646, 43675, 43704, 43734, 43763, 43793, 43822, 43852, 43882, 43911, // 1479
+	43941, 43970, 44000, 44030, 44059, 44088, 44118, 44147, 44177, 44206, 44236, 44265, // 1480
+	44295, 44324, 44354, 44384, 44413, 44443, 44473, 44502, 44532, 44561, 44590, 44620, // 1481
+	44649, 44679, 44708, 44738, 44768, 44797, 44827, 44857, 44886, 44916, 44945, 44974, // 1482
+	45004, 45033, 45062, 45092, 45122, 45151, 45181, 45211, 45241, 45270, 45300, 45329, // 1483
+	45358, 45388, 45417, 45446, 45476, 45506, 45535, 45565, 45595, 45624, 45654, 45684, // 1484
+	45713, 45742, 45772, 45801, 45830, 45860, 45890, 45919, 45949, 45978, 46008, 46038, // 1485
+	46068, 46097, 46126, 46156, 46185, 46215, 46244, 46274, 46303, 46333, 46362, 46392, // 1486
+	46422, 46451, 46481, 46510, 46540, 46569, 46599, 46628, 46657, 46687, 46716, 46746, // 1487
+	46776, 46805, 46835, 46865, 46894, 46924, 46953, 46983, 47012, 47041, 47071, 47100, // 1488
+	47130, 47159, 47189, 47219, 47249, 47278, 47308, 47337, 47367, 47396, 47425, 47455, // 1489
+	47484, 47514, 47543, 47573, 47603, 47632, 47662, 47692, 47721, 47751, 47780, 47809, // 1490
+	47839, 47868, 47898, 47927, 47957, 47986, 48016, 48046, 48075, 48105, 48134, 48164, // 1491
+	48194, 48223, 48252, 48282, 48311, 48341, 48370, 48400, 48429, 48459, 48489, 48518, // 1492
+	48548, 48578, 48607, 48636, 48666, 48695, 48725, 48754, 48783, 48813, 48843, 48872, // 1493
+	48902, 48932, 48962, 48991, 49020, 49050, 49079, 49108, 49138, 49167, 49197, 49226, // 1494
+	49256, 49286, 49316, 49345, 49375, 49404, 49434, 49463, 49492, 49522, 49551, 49581, // 1495
+	49610, 49640, 49670, 49700, 49729, 49759, 49788, 49818, 49847, 49876, 49906, 49935, // 1496
+	49965, 49994, 50024, 50054, 50083, 50113, 50143, 50172, 50201, 50231, 50260, 50290, // 1497
+	50319, 50349, 50378, 50408, 50437, 50467, 50497, 50526, 50556, 50585, 50615, 50644, // 1498
+	50674, 50703, 50733, 50762, 50792, 50821, 50851, 50880, 50910, 50939, 50969, 50999, // 1499
+	51028, 51058, 51088, 51117, 51146, 51176, 51205, 51234, 51264, 51293, 51323, 51353, // 1500
+	51383, // 1501
+}
@@ -0,0 +1,4938 @@
+// Code generated by cldrgen from CLDR data; DO NOT EDIT.
+
+package strftime
+
+import "golang.org/x/text/language"
+
+// cldrLocales contains the locales generated from CLDR data
+var cldrLocales = []*Locale{
+	{
+		Tag:       language.MustParse("af-NA"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"vm.", "nm."},
+		AbDay:     [7]string{"So.", "Ma.", "Di.", "Wo.", "Do.", "Vr.", "Sa."},
+		Day:       [7]string{"Sondag", "Maandag", "Dinsdag", "Woensdag", "Donderdag", "Vrydag", "Saterdag"},
+		AbMonth:   [12]string{"Jan.", "Feb.", "Mrt.", "Apr.", "Mei", "Jun.", "Jul.", "Aug.", "Sep.", "Okt.", "Nov.", "Des."},
+		Month:     [12]string{"Januarie", "Februarie", "Maart", "April", "Mei", "Junie", "Julie", "Augustus", "September", "Oktober", "November", "Desember"},
+	},
+	{
+		Tag:       language.MustParse("af"),
+		DTfmt:     "%d %b %Y %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"vm.", "nm."},
+		AbDay:     [7]string{"So.", "Ma.", "Di.", "Wo.", "Do.", "Vr.", "Sa."},
+		Day:       [7]string{"Sondag", "Maandag", "Dinsdag", "Woensdag", "Donderdag", "Vrydag", "Saterdag"},
+		AbMonth:   [12]string{"Jan.", "Feb.", "Mrt.", "Apr.", "Mei", "Jun.", "Jul.", "Aug.", "Sep.", "Okt.", "Nov.", "Des."},
+		Month:     [12]string{"Januarie", "Februarie", "Maart", "April", "Mei", "Junie", "Julie", "Augustus", "September", "Oktober", "November", "Desember"},
+	},
+	{
+		Tag:       language.MustParse("agq"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.g", "a.k"},
+		AbDay:     [7]string{"nts", "kpa", "ghɔ", "tɔm", "ume", "ghɨ", "dzk"},
+		Day:       [7]string{"tsuʔntsɨ", "tsuʔukpà", "tsuʔughɔe", "tsuʔutɔ̀mlò", "tsuʔumè", "tsuʔughɨ̂m", "tsuʔndzɨkɔʔɔ"},
+		AbMonth:   [12]string{"nùm", "kɨz", "tɨd", "taa", "see", "nzu", "dum", "fɔe", "dzu", "lɔm", "kaa", "fwo"},
+		Month:     [12]string{"ndzɔ̀ŋɔ̀nùm", "ndzɔ̀ŋɔ̀kƗ̀zùʔ", "ndzɔ̀ŋɔ̀tƗ̀dʉ̀ghà", "ndzɔ̀ŋɔ̀tǎafʉ̄ghā", "ndzɔ̀ŋèsèe", "ndzɔ̀ŋɔ̀nzùghò", "ndzɔ̀ŋɔ̀dùmlo", "ndzɔ̀ŋɔ̀kwîfɔ̀e", "ndzɔ̀ŋɔ̀tƗ̀fʉ̀ghàdzughù", "ndzɔ̀ŋɔ̀ghǔuwelɔ̀m", "ndzɔ̀ŋɔ̀chwaʔàkaa wo", "ndzɔ̀ŋèfwòo"},
+	},
+	{
+		Tag:       language.MustParse("ak"),
+		DTfmt:     "%Y %b %-d %-I:%M:%S %p",
+		Dfmt:      "%Y/%m/%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y/%m/%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AN", "EW"},
+		AbDay:     [7]string{"Kwe", "Dwo", "Ben", "Wuk", "Yaw", "Fia", "Mem"},
+		Day:       [7]string{"Kwesida", "Dwowda", "Benada", "Wukuda", "Yawda", "Fida", "Memeneda"},
+		AbMonth:   [12]string{"S-Ɔ", "K-Ɔ", "E-Ɔ", "E-O", "E-K", "O-A", "A-K", "D-Ɔ", "F-Ɛ", "Ɔ-A", "Ɔ-O", "M-Ɔ"},
+		Month:     [12]string{"Sanda-Ɔpɛpɔn", "Kwakwar-Ɔgyefuo", "Ebɔw-Ɔbenem", "Ebɔbira-Oforisuo", "Esusow Aketseaba-Kɔtɔnimba", "Obirade-Ayɛwohomumu", "Ayɛwoho-Kitawonsa", "Difuu-Ɔsandaa", "Fankwa-Ɛbɔ", "Ɔbɛsɛ-Ahinime", "Ɔberɛfɛw-Obubuo", "Mumu-Ɔpɛnimba"},
+	},
+	{
+		Tag:       language.MustParse("am"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ጥዋት", "ከሰዓት"},
+		AbDay:     [7]string{"እሑድ", "ሰኞ", "ማክሰ", "ረቡዕ", "ሐሙስ", "ዓርብ", "ቅዳሜ"},
+		Day:       [7]string{"እሑድ", "ሰኞ", "ማክሰኞ", "ረቡዕ", "ሐሙስ", "ዓርብ", "ቅዳሜ"},
+		AbMonth:   [12]string{"ጃንዩ", "ፌብሩ", "ማርች", "ኤፕሪ", "ሜይ", "ጁን", "ጁላይ", "ኦገስ", "ሴፕቴ", "ኦክቶ", "ኖቬም", "ዲሴም"},
+		Month:     [12]string{"ጃንዩወሪ", "ፌብሩወሪ", "ማርች", "ኤፕሪል", "ሜይ", "ጁን", "ጁላይ", "ኦገስት", "ሴፕቴምበር", "ኦክቶበር", "ኖቬምበር", "ዲሴምበር"},
+	},
+	{
+		Tag:       language.MustParse("ar-DZ"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:     [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+	{
+		Tag:       language.MustParse("ar-IL"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-H:%M:%S",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:     [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+	{
+		Tag:        language.MustParse("ar-IQ"),
+		DTfmt:      "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:       "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d\u200f/%-m\u200f/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"ص", "م"},
+		AbDay:      [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:        [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:    [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين\u00a0الأول", "تشرين الثاني", "كانون الأول"},
+		Month:      [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		AbAltMonth: [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		AltMonth:   [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+	},
+	{
+		Tag:       language.MustParse("ar-JO"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		Month:     [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+	},
+	{
+		Tag:       language.MustParse("ar-KM"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %H:%M:%S",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:     [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+	{
+		Tag:       language.MustParse("ar-LB"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		Month:     [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+	},
+	{
+		Tag:       language.MustParse("ar-MA"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %H:%M:%S",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "ماي", "يونيو", "يوليوز", "غشت", "شتنبر", "أكتوبر", "نونبر", "دجنبر"},
+		Month:     [12]string{"يناير", "فبراير", "مارس", "أبريل", "ماي", "يونيو", "يوليوز", "غشت", "شتنبر", "أكتوبر", "نونبر", "دجنبر"},
+	},
+	{
+		Tag:       language.MustParse("ar-MR"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"يناير", "فبراير", "مارس", "إبريل", "مايو", "يونيو", "يوليو", "أغشت", "شتمبر", "أكتوبر", "نوفمبر", "دجمبر"},
+		Month:     [12]string{"يناير", "فبراير", "مارس", "إبريل", "مايو", "يونيو", "يوليو", "أغشت", "شتمبر", "أكتوبر", "نوفمبر", "دجمبر"},
+	},
+	{
+		Tag:       language.MustParse("ar-PS"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		Month:     [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+	},
+	{
+		Tag:       language.MustParse("ar-SY"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+		Month:     [12]string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"},
+	},
+	{
+		Tag:       language.MustParse("ar-TN"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:     [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+	{
+		Tag:       language.MustParse("ar"),
+		DTfmt:     "%d\u200f/%m\u200f/%Y %-I:%M:%S %p",
+		Dfmt:      "%-d\u200f/%-m\u200f/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d\u200f/%-m\u200f/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ص", "م"},
+		AbDay:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		Day:       [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		AbMonth:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		Month:     [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+	{
+		Tag:       language.MustParse("as"),
+		DTfmt:     "%d-%m-%Y %-I.%M.%S %p",
+		Dfmt:      "%-d-%-m-%Y",
+		Tfmt:      "%-I.%M.%S %p",
+		Tfmt12:    "%-I.%M.%S %p",
+		DfmtShort: "%-d-%-m-%y",
+		TfmtShort: "%-I.%M. %p",
+		AmPm:      [2]string{"পূৰ্বাহ্ণ", "অপৰাহ্ণ"},
+		AbDay:     [7]string{"দেও", "সোম", "মঙ্গল", "বুধ", "বৃহ", "শুক্ৰ", "শনি"},
+		Day:       [7]string{"দেওবাৰ", "সোমবাৰ", "মঙ্গলবাৰ", "বুধবাৰ", "বৃহস্পতিবাৰ", "শুক্ৰবাৰ", "শনিবাৰ"},
+		AbMonth:   [12]string{"জানু", "ফেব্ৰু", "মাৰ্চ", "এপ্ৰিল", "মে’", "জুন", "জুলাই", "আগ", "ছেপ্তে", "অক্টো", "নৱে", "ডিচে"},
+		Month:     [12]string{"জানুৱাৰী", "ফেব্ৰুৱাৰী", "মাৰ্চ", "এপ্ৰিল", "মে’", "জুন", "জুলাই", "আগষ্ট", "ছেপ্তেম্বৰ", "অক্টোবৰ", "নৱেম্বৰ", "ডিচেম্বৰ"},
+	},
+	{
+		Tag:       language.MustParse("asa"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"icheheavo", "ichamthi"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jnn", "Jtn", "Alh", "Ijm", "Jmo"},
+		Day:       [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Dec"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprili", "Mei", "Juni", "Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:        language.MustParse("ast"),
+		DTfmt:      "%-d %b %Y, %H:%M:%S",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"dom", "llu", "mar", "mié", "xue", "vie", "sáb"},
+		Day:        [7]string{"domingu", "llunes", "martes", "miércoles", "xueves", "vienres", "sábadu"},
+		AbMonth:    [12]string{"xin", "feb", "mar", "abr", "may", "xun", "xnt", "ago", "set", "och", "pay", "avi"},
+		Month:      [12]string{"de xineru", "de febreru", "de marzu", "d’abril", "de mayu", "de xunu", "de xunetu", "d’agostu", "de setiembre", "d’ochobre", "de payares", "d’avientu"},
+		AbAltMonth: [12]string{"Xin", "Feb", "Mar", "Abr", "May", "Xun", "Xnt", "Ago", "Set", "Och", "Pay", "Avi"},
+		AltMonth:   [12]string{"xineru", "febreru", "marzu", "abril", "mayu", "xunu", "xunetu", "agostu", "setiembre", "ochobre", "payares", "avientu"},
+	},
+	{
+		Tag:        language.MustParse("az-Cyrl"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"АМ", "ПМ"},
+		AbDay:      [7]string{"Б.", "Б.Е.", "Ч.А.", "Ч.", "Ҹ.А.", "Ҹ.", "Ш."},
+		Day:        [7]string{"базар", "базар ертәси", "чәршәнбә ахшамы", "чәршәнбә", "ҹүмә ахшамы", "ҹүмә", "шәнбә"},
+		AbMonth:    [12]string{"јан", "фев", "мар", "апр", "май", "ијн", "ијл", "авг", "сен", "окт", "ној", "дек"},
+		Month:      [12]string{"јанвар", "феврал", "март", "апрел", "май", "ијун", "ијул", "август", "сентјабр", "октјабр", "нојабр", "декабр"},
+		AbAltMonth: [12]string{"јан", "фев", "мар", "апр", "май", "ијн", "ијл", "авг", "сен", "окт", "ној", "дек"},
+		AltMonth:   [12]string{"Јанвар", "Феврал", "Март", "Апрел", "Май", "Ијун", "Ијул", "Август", "Сентјабр", "Октјабр", "Нојабр", "Декабр"},
+	},
+	{
+		Tag:        language.MustParse("az"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"B.", "B.E.", "Ç.A.", "Ç.", "C.A.", "C.", "Ş."},
+		Day:        [7]string{"bazar", "bazar ertəsi", "çərşənbə axşamı", "çərşənbə", "cümə axşamı", "cümə", "şənbə"},
+		AbMonth:    [12]string{"yan", "fev", "mar", "apr", "may", "iyn", "iyl", "avq", "sen", "okt", "noy", "dek"},
+		Month:      [12]string{"yanvar", "fevral", "mart", "aprel", "may", "iyun", "iyul", "avqust", "sentyabr", "oktyabr", "noyabr", "dekabr"},
+		AbAltMonth: [12]string{"yan", "fev", "mar", "apr", "may", "iyn", "iyl", "avq", "sen", "okt", "noy", "dek"},
+		AltMonth:   [12]string{"Yanvar", "Fevral", "Mart", "Aprel", "May", "İyun", "İyul", "Avqust", "Sentyabr", "Oktyabr", "Noyabr", "Dekabr"},
+	},
+	{
+		Tag:       language.MustParse("bas"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"I bikɛ̂glà", "I ɓugajɔp"},
+		AbDay:     [7]string{"nɔy", "nja", "uum", "ŋge", "mbɔ", "kɔɔ", "jon"},
+		Day:       [7]string{"ŋgwà nɔ̂y", "ŋgwà njaŋgumba", "ŋgwà ûm", "ŋgwà ŋgê", "ŋgwà mbɔk", "ŋgwà kɔɔ", "ŋgwà jôn"},
+		AbMonth:   [12]string{"kɔn", "mac", "mat", "mto", "mpu", "hil", "nje", "hik", "dip", "bio", "may", "liɓ"},
+		Month:     [12]string{"Kɔndɔŋ", "Màcɛ̂l", "Màtùmb", "Màtop", "M̀puyɛ", "Hìlòndɛ̀", "Njèbà", "Hìkaŋ", "Dìpɔ̀s", "Bìòôm", "Màyɛsèp", "Lìbuy li ńyèe"},
+	},
+	{
+		Tag:        language.MustParse("be"),
+		DTfmt:      "%-d.%m.%Y, %H:%M:%S",
+		Dfmt:       "%-d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"нд", "пн", "аў", "ср", "чц", "пт", "сб"},
+		Day:        [7]string{"нядзеля", "панядзелак", "аўторак", "серада", "чацвер", "пятніца", "субота"},
+		AbMonth:    [12]string{"сту", "лют", "сак", "кра", "мая", "чэр", "ліп", "жні", "вер", "кас", "ліс", "сне"},
+		Month:      [12]string{"студзеня", "лютага", "сакавіка", "красавіка", "мая", "чэрвеня", "ліпеня", "жніўня", "верасня", "кастрычніка", "лістапада", "снежня"},
+		AbAltMonth: [12]string{"сту", "лют", "сак", "кра", "май", "чэр", "ліп", "жні", "вер", "кас", "ліс", "сне"},
+		AltMonth:   [12]string{"студзень", "люты", "сакавік", "красавік", "май", "чэрвень", "ліпень", "жнівень", "верасень", "кастрычнік", "лістапад", "снежань"},
+	},
+	{
+		Tag:       language.MustParse("bem"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"uluchelo", "akasuba"},
+		AbDay:     [7]string{"Pa Mulungu", "Palichimo", "Palichibuli", "Palichitatu", "Palichine", "Palichisano", "Pachibelushi"},
+		Day:       [7]string{"Pa Mulungu", "Palichimo", "Palichibuli", "Palichitatu", "Palichine", "Palichisano", "Pachibelushi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Epr", "Mei", "Jun", "Jul", "Oga", "Sep", "Okt", "Nov", "Dis"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Epreo", "Mei", "Juni", "Julai", "Ogasti", "Septemba", "Oktoba", "Novemba", "Disemba"},
+	},
+	{
+		Tag:       language.MustParse("bez"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"pamilau", "pamunyi"},
+		AbDay:     [7]string{"Mul", "Vil", "Hiv", "Hid", "Hit", "Hih", "Lem"},
+		Day:       [7]string{"pa mulungu", "pa shahuviluha", "pa hivili", "pa hidatu", "pa hitayi", "pa hihanu", "pa shahulembela"},
+		AbMonth:   [12]string{"Hut", "Vil", "Dat", "Tai", "Han", "Sit", "Sab", "Nan", "Tis", "Kum", "Kmj", "Kmb"},
+		Month:     [12]string{"pa mwedzi gwa hutala", "pa mwedzi gwa wuvili", "pa mwedzi gwa wudatu", "pa mwedzi gwa wutai", "pa mwedzi gwa wuhanu", "pa mwedzi gwa sita", "pa mwedzi gwa saba", "pa mwedzi gwa nane", "pa mwedzi gwa tisa", "pa mwedzi gwa kumi", "pa mwedzi gwa kumi na moja", "pa mwedzi gwa kumi na mbili"},
+	},
+	{
+		Tag:       language.MustParse("bg"),
+		DTfmt:     "%-d.%m.%Y г., %-H:%M:%S ч.",
+		Dfmt:      "%-d.%m.%Y г.",
+		Tfmt:      "%-H:%M:%S ч.",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%m.%y г.",
+		TfmtShort: "%-H:%M ч.",
+		AmPm:      [2]string{"am", "pm"},
+		AbDay:     [7]string{"нд", "пн", "вт", "ср", "чт", "пт", "сб"},
+		Day:       [7]string{"неделя", "понеделник", "вторник", "сряда", "четвъртък", "петък", "събота"},
+		AbMonth:   [12]string{"яну", "фев", "март", "апр", "май", "юни", "юли", "авг", "сеп", "окт", "ное", "дек"},
+		Month:     [12]string{"януари", "февруари", "март", "април", "май", "юни", "юли", "август", "септември", "октомври", "ноември", "декември"},
+	},
+	{
+		Tag:       language.MustParse("bm"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"kar", "ntɛ", "tar", "ara", "ala", "jum", "sib"},
+		Day:       [7]string{"kari", "ntɛnɛ", "tarata", "araba", "alamisa", "juma", "sibiri"},
+		AbMonth:   [12]string{"zan", "feb", "mar", "awi", "mɛ", "zuw", "zul", "uti", "sɛt", "ɔku", "now", "des"},
+		Month:     [12]string{"zanwuye", "feburuye", "marisi", "awirili", "mɛ", "zuwɛn", "zuluye", "uti", "sɛtanburu", "ɔkutɔburu", "nowanburu", "desanburu"},
+	},
+	{
+		Tag:        language.MustParse("bn"),
+		DTfmt:      "%-d %b, %Y %-I:%M:%S %p",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"রবি", "সোম", "মঙ্গল", "বুধ", "বৃহস্পতি", "শুক্র", "শনি"},
+		Day:        [7]string{"রবিবার", "সোমবার", "মঙ্গলবার", "বুধবার", "বৃহস্পতিবার", "শুক্রবার", "শনিবার"},
+		AbMonth:    [12]string{"জানু", "ফেব", "মার্চ", "এপ্রিল", "মে", "জুন", "জুলাই", "আগস্ট", "সেপ্টেম্বর", "অক্টোবর", "নভেম্বর", "ডিসেম্বর"},
+		Month:      [12]string{"জানুয়ারী", "ফেব্রুয়ারী", "মার্চ", "এপ্রিল", "মে", "জুন", "জুলাই", "আগস্ট", "সেপ্টেম্বর", "অক্টোবর", "নভেম্বর", "ডিসেম্বর"},
+		AbAltMonth: [12]string{"জানুয়ারী", "ফেব্রুয়ারী", "মার্চ", "এপ্রিল", "মে", "জুন", "জুলাই", "আগস্ট", "সেপ্টেম্বর", "অক্টোবর", "নভেম্বর", "ডিসেম্বর"},
+		AltMonth:   [12]string{"জানুয়ারী", "ফেব্রুয়ারী", "মার্চ", "এপ্রিল", "মে", "জুন", "জুলাই", "আগস্ট", "সেপ্টেম্বর", "অক্টোবর", "নভেম্বর", "ডিসেম্বর"},
+	},
+	{
+		Tag:        language.MustParse("bo"),
+		DTfmt:      "%Y ལོའི་%bཚེས་%-d %-I:%M:%S %p",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"སྔ་དྲོ་", "ཕྱི་དྲོ་"},
+		AbDay:      [7]string{"ཉི་མ་", "ཟླ་བ་", "མིག་དམར་", "ལྷག་པ་", "ཕུར་བུ་", "པ་སངས་", "སྤེན་པ་"},
+		Day:        [7]string{"གཟའ་ཉི་མ་", "གཟའ་ཟླ་བ་", "གཟའ་མིག་དམར་", "གཟའ་ལྷག་པ་", "གཟའ་ཕུར་བུ་", "གཟའ་པ་སངས་", "གཟའ་སྤེན་པ་"},
+		AbMonth:    [12]string{"ཟླ་༡", "ཟླ་༢", "ཟླ་༣", "ཟླ་༤", "ཟླ་༥", "ཟླ་༦", "ཟླ་༧", "ཟླ་༨", "ཟླ་༩", "ཟླ་༡༠", "ཟླ་༡༡", "ཟླ་༡༢"},
+		Month:      [12]string{"ཟླ་བ་དང་པོ", "ཟླ་བ་གཉིས་པ", "ཟླ་བ་གསུམ་པ", "ཟླ་བ་བཞི་པ", "ཟླ་བ་ལྔ་པ", "ཟླ་བ་དྲུག་པ", "ཟླ་བ་བདུན་པ", "ཟླ་བ་བརྒྱད་པ", "ཟླ་བ་དགུ་པ", "ཟླ་བ་བཅུ་པ", "ཟླ་བ་བཅུ་གཅིག་པ", "ཟླ་བ་བཅུ་གཉིས་པ"},
+		AbAltMonth: [12]string{"ཟླ་༡", "ཟླ་༢", "ཟླ་༣", "ཟླ་༤", "ཟླ་༥", "ཟླ་༦", "ཟླ་༧", "ཟླ་༨", "ཟླ་༩", "ཟླ་༡༠", "ཟླ་༡༡", "ཟླ་༡༢"},
+		AltMonth:   [12]string{"ཟླ་བ་དང་པོ་", "ཟླ་བ་གཉིས་པ་", "ཟླ་བ་གསུམ་པ་", "ཟླ་བ་བཞི་པ་", "ཟླ་བ་ལྔ་པ་", "ཟླ་བ་དྲུག་པ་", "ཟླ་བ་བདུན་པ་", "ཟླ་བ་བརྒྱད་པ་", "ཟླ་བ་དགུ་པ་", "ཟླ་བ་བཅུ་པ་", "ཟླ་བ་བཅུ་གཅིག་པ་", "ཟླ་བ་བཅུ་གཉིས་པ་"},
+	},
+	{
+		Tag:        language.MustParse("br"),
+		DTfmt:      "%Y %b %-d %H:%M:%S",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"A.M.", "G.M."},
+		AbDay:      [7]string{"Sul", "Lun", "Meu.", "Mer.", "Yaou", "Gwe.", "Sad."},
+		Day:        [7]string{"Sul", "Lun", "Meurzh", "Mercʼher", "Yaou", "Gwener", "Sadorn"},
+		AbMonth:    [12]string{"Gen.", "Cʼhwe.", "Meur.", "Ebr.", "Mae", "Mezh.", "Goue.", "Eost", "Gwen.", "Here", "Du", "Kzu."},
+		Month:      [12]string{"Genver", "Cʼhwevrer", "Meurzh", "Ebrel", "Mae", "Mezheven", "Gouere", "Eost", "Gwengolo", "Here", "Du", "Kerzu"},
+		AbAltMonth: [12]string{"Gen.", "Cʼhwe.", "Meur.", "Ebr.", "Mae", "Mezh.", "Goue.", "Eost", "Gwen.", "Here", "Du", "Ker."},
+		AltMonth:   [12]string{"Genver", "Cʼhwevrer", "Meurzh", "Ebrel", "Mae", "Mezheven", "Gouere", "Eost", "Gwengolo", "Here", "Du", "Kerzu"},
+	},
+	{
+		Tag:       language.MustParse("brx"),
+		DTfmt:     "%b %-d, %Y %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"फुं", "बेलासे"},
+		AbDay:     [7]string{"रबि", "सम", "मंगल", "बुद", "बिसथि", "सुखुर", "सुनि"},
+		Day:       [7]string{"रबिबार", "समबार", "मंगलबार", "बुदबार", "बिसथिबार", "सुखुरबार", "सुनिबार"},
+		AbMonth:   [12]string{"जानुवारी", "फेब्रुवारी", "मार्स", "एफ्रिल", "मे", "जुन", "जुलाइ", "आगस्थ", "सेबथेज्ब़र", "अखथबर", "नबेज्ब़र", "दिसेज्ब़र"},
+		Month:     [12]string{"जानुवारी", "फेब्रुवारी", "मार्स", "एफ्रिल", "मे", "जुन", "जुलाइ", "आगस्थ", "सेबथेज्ब़र", "अखथबर", "नबेज्ब़र", "दिसेज्ब़र"},
+	},
+	{
+		Tag:       language.MustParse("bs-Cyrl"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"пре подне", "поподне"},
+		AbDay:     [7]string{"нед", "пон", "уто", "сри", "чет", "пет", "суб"},
+		Day:       [7]string{"недјеља", "понедјељак", "уторак", "сриједа", "четвртак", "петак", "субота"},
+		AbMonth:   [12]string{"јан", "феб", "мар", "апр", "мај", "јун", "јул", "ауг", "сеп", "окт", "нов", "дец"},
+		Month:     [12]string{"јануар", "фебруар", "март", "април", "мај", "јуни", "јули", "аугуст", "септембар", "октобар", "новембар", "децембар"},
+	},
+	{
+		Tag:       language.MustParse("bs"),
+		DTfmt:     "%-d. %b %Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"prijepodne", "popodne"},
+		AbDay:     [7]string{"ned", "pon", "uto", "sri", "čet", "pet", "sub"},
+		Day:       [7]string{"nedjelja", "ponedjeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		AbMonth:   [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "avg", "sep", "okt", "nov", "dec"},
+		Month:     [12]string{"januar", "februar", "mart", "april", "maj", "juni", "juli", "avgust", "septembar", "oktobar", "novembar", "decembar"},
+	},
+	{
+		Tag:        language.MustParse("ca"),
+		DTfmt:      "%-d %b %Y, %-H:%M:%S",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dg.", "dl.", "dt.", "dc.", "dj.", "dv.", "ds."},
+		Day:        [7]string{"diumenge", "dilluns", "dimarts", "dimecres", "dijous", "divendres", "dissabte"},
+		AbMonth:    [12]string{"de gen.", "de febr.", "de març", "d’abr.", "de maig", "de juny", "de jul.", "d’ag.", "de set.", "d’oct.", "de nov.", "de des."},
+		Month:      [12]string{"de gener", "de febrer", "de març", "d’abril", "de maig", "de juny", "de juliol", "d’agost", "de setembre", "d’octubre", "de novembre", "de desembre"},
+		AbAltMonth: [12]string{"gen.", "febr.", "març", "abr.", "maig", "juny", "jul.", "ag.", "set.", "oct.", "nov.", "des."},
+		AltMonth:   [12]string{"gener", "febrer", "març", "abril", "maig", "juny", "juliol", "agost", "setembre", "octubre", "novembre", "desembre"},
+	},
+	{
+		Tag:        language.MustParse("ccp"),
+		DTfmt:      "%-d %b, %Y %-I:%M:%S %p",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"𑄢𑄧𑄝𑄨", "𑄥𑄧𑄟𑄴", "𑄟𑄧𑄁𑄉𑄧𑄣𑄴", "𑄝𑄪𑄖𑄴", "𑄝𑄳𑄢𑄨𑄥𑄪𑄛𑄴", "𑄥𑄪𑄇𑄴𑄇𑄮𑄢𑄴", "𑄥𑄧𑄚𑄨"},
+		Day:        [7]string{"𑄢𑄧𑄝𑄨𑄝𑄢𑄴", "𑄥𑄧𑄟𑄴𑄝𑄢𑄴", "𑄟𑄧𑄁𑄉𑄧𑄣𑄴𑄝𑄢𑄴", "𑄝𑄪𑄖𑄴𑄝𑄢𑄴", "𑄝𑄳𑄢𑄨𑄥𑄪𑄛𑄴𑄝𑄢𑄴", "𑄥𑄪𑄇𑄴𑄇𑄮𑄢𑄴𑄝𑄢𑄴", "𑄥𑄧𑄚𑄨𑄝𑄢𑄴"},
+		AbMonth:    [12]string{"𑄎𑄚𑄪", "𑄜𑄬𑄛𑄴", "𑄟𑄢𑄴𑄌𑄧", "𑄃𑄬𑄛𑄳𑄢𑄨𑄣𑄴", "𑄟𑄬", "𑄎𑄪𑄚𑄴", "𑄎𑄪𑄣𑄭", "𑄃𑄉𑄧𑄌𑄴𑄑𑄴", "𑄥𑄬𑄛𑄴𑄑𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄃𑄧𑄇𑄴𑄑𑄮𑄝𑄧𑄢𑄴", "𑄚𑄧𑄞𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄓𑄨𑄥𑄬𑄟𑄴𑄝𑄢𑄴"},
+		Month:      [12]string{"𑄎𑄚𑄪𑄠𑄢𑄨", "𑄜𑄬𑄛𑄴𑄝𑄳𑄢𑄪𑄠𑄢𑄨", "𑄟𑄢𑄴𑄌𑄧", "𑄃𑄬𑄛𑄳𑄢𑄨𑄣𑄴", "𑄟𑄬", "𑄎𑄪𑄚𑄴", "𑄎𑄪𑄣𑄭", "𑄃𑄉𑄧𑄌𑄴𑄑𑄴", "𑄥𑄬𑄛𑄴𑄑𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄃𑄧𑄇𑄴𑄑𑄬𑄝𑄧𑄢𑄴", "𑄚𑄧𑄞𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄓𑄨𑄥𑄬𑄟𑄴𑄝𑄧𑄢𑄴"},
+		AbAltMonth: [12]string{"𑄎𑄚𑄪𑄠𑄢𑄨", "𑄜𑄬𑄛𑄴𑄝𑄳𑄢𑄪𑄠𑄢𑄨", "𑄟𑄢𑄴𑄌𑄧", "𑄃𑄬𑄛𑄳𑄢𑄨𑄣𑄴", "𑄟𑄬", "𑄎𑄪𑄚𑄴", "𑄎𑄪𑄣𑄭", "𑄃𑄉𑄧𑄌𑄴𑄑𑄴", "𑄥𑄬𑄛𑄴𑄑𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄃𑄧𑄇𑄴𑄑𑄮𑄝𑄧𑄢𑄴", "𑄚𑄧𑄞𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄓𑄨𑄥𑄬𑄟𑄴𑄝𑄧𑄢𑄴"},
+		AltMonth:   [12]string{"𑄎𑄚𑄪𑄠𑄢𑄨", "𑄜𑄬𑄛𑄴𑄝𑄳𑄢𑄪𑄠𑄢𑄨", "𑄟𑄢𑄴𑄌𑄧", "𑄃𑄬𑄛𑄳𑄢𑄨𑄣𑄴", "𑄟𑄬", "𑄎𑄪𑄚𑄴", "𑄎𑄪𑄣𑄭", "𑄃𑄉𑄧𑄌𑄴𑄑𑄴", "𑄥𑄬𑄛𑄴𑄑𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄃𑄧𑄇𑄴𑄑𑄮𑄝𑄧𑄢𑄴", "𑄚𑄧𑄞𑄬𑄟𑄴𑄝𑄧𑄢𑄴", "𑄓𑄨𑄥𑄬𑄟𑄴𑄝𑄧𑄢𑄴"},
+	},
+	{
+		Tag:       language.MustParse("ce"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"кӀи", "ор", "ши", "кха", "еа", "пӀе", "шуо"},
+		Day:       [7]string{"кӀира", "оршот", "шинара", "кхаара", "еара", "пӀераска", "шуот"},
+		AbMonth:   [12]string{"янв", "фев", "мар", "апр", "май", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
+		Month:     [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	},
+	{
+		Tag:       language.MustParse("cgg"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"SAN", "ORK", "OKB", "OKS", "OKN", "OKT", "OMK"},
+		Day:       [7]string{"Sande", "Orwokubanza", "Orwakabiri", "Orwakashatu", "Orwakana", "Orwakataano", "Orwamukaaga"},
+		AbMonth:   [12]string{"KBZ", "KBR", "KST", "KKN", "KTN", "KMK", "KMS", "KMN", "KMW", "KKM", "KNK", "KNB"},
+		Month:     [12]string{"Okwokubanza", "Okwakabiri", "Okwakashatu", "Okwakana", "Okwakataana", "Okwamukaaga", "Okwamushanju", "Okwamunaana", "Okwamwenda", "Okwaikumi", "Okwaikumi na kumwe", "Okwaikumi na ibiri"},
+	},
+	{
+		Tag:       language.MustParse("chr"),
+		DTfmt:     "%b %-d, %Y, %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ᏌᎾᎴ", "ᏒᎯᏱᎢᏗᏢ"},
+		AbDay:     [7]string{"ᏆᏍᎬ", "ᏉᏅᎯ", "ᏔᎵᏁ", "ᏦᎢᏁ", "ᏅᎩᏁ", "ᏧᎾᎩ", "ᏈᏕᎾ"},
+		Day:       [7]string{"ᎤᎾᏙᏓᏆᏍᎬ", "ᎤᎾᏙᏓᏉᏅᎯ", "ᏔᎵᏁᎢᎦ", "ᏦᎢᏁᎢᎦ", "ᏅᎩᏁᎢᎦ", "ᏧᎾᎩᎶᏍᏗ", "ᎤᎾᏙᏓᏈᏕᎾ"},
+		AbMonth:   [12]string{"ᎤᏃ", "ᎧᎦ", "ᎠᏅ", "ᎧᏬ", "ᎠᏂ", "ᏕᎭ", "ᎫᏰ", "ᎦᎶ", "ᏚᎵ", "ᏚᏂ", "ᏅᏓ", "ᎥᏍ"},
+		Month:     [12]string{"ᎤᏃᎸᏔᏅ", "ᎧᎦᎵ", "ᎠᏅᏱ", "ᎧᏬᏂ", "ᎠᏂᏍᎬᏘ", "ᏕᎭᎷᏱ", "ᎫᏰᏉᏂ", "ᎦᎶᏂ", "ᏚᎵᏍᏗ", "ᏚᏂᏅᏗ", "ᏅᏓᏕᏆ", "ᎥᏍᎩᏱ"},
+	},
+	{
+		Tag:       language.MustParse("ckb-IR"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ب.ن", "د.ن"},
+		AbDay:     [7]string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "ھەینی", "شەممە"},
+		Day:       [7]string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "ھەینی", "شەممە"},
+		AbMonth:   [12]string{"کانوونی دووەم", "شوبات", "ئازار", "نیسان", "ئایار", "حوزەیران", "تەمووز", "ئاب", "ئەیلوول", "تشرینی یەکەم", "تشرینی دووەم", "کانونی یەکەم"},
+		Month:     [12]string{"کانوونی دووەم", "شوبات", "ئازار", "نیسان", "ئایار", "حوزەیران", "تەمووز", "ئاب", "ئەیلوول", "تشرینی یەکەم", "تشرینی دووەم", "کانونی یەکەم"},
+	},
+	{
+		Tag:       language.MustParse("ckb"),
+		DTfmt:     "%Y %b %-d %-I:%M:%S %p",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ب.ن", "د.ن"},
+		AbDay:     [7]string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "ھەینی", "شەممە"},
+		Day:       [7]string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "ھەینی", "شەممە"},
+		AbMonth:   [12]string{"کانوونی دووەم", "شوبات", "ئازار", "نیسان", "ئایار", "حوزەیران", "تەمووز", "ئاب", "ئەیلوول", "تشرینی یەکەم", "تشرینی دووەم", "کانونی یەکەم"},
+		Month:     [12]string{"کانوونی دووەم", "شوبات", "ئازار", "نیسان", "ئایار", "حوزەیران", "تەمووز", "ئاب", "ئەیلوول", "تشرینی یەکەم", "تشرینی دووەم", "کانونی یەکەم"},
+	},
+	{
+		Tag:        language.MustParse("cs"),
+		DTfmt:      "%-d. %-m. %Y %-H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"dop.", "odp."},
+		AbDay:      [7]string{"ne", "po", "út", "st", "čt", "pá", "so"},
+		Day:        [7]string{"neděle", "pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota"},
+		AbMonth:    [12]string{"led", "úno", "bře", "dub", "kvě", "čvn", "čvc", "srp", "zář", "říj", "lis", "pro"},
+		Month:      [12]string{"ledna", "února", "března", "dubna", "května", "června", "července", "srpna", "září", "října", "listopadu", "prosince"},
+		AbAltMonth: [12]string{"led", "úno", "bře", "dub", "kvě", "čvn", "čvc", "srp", "zář", "říj", "lis", "pro"},
+		AltMonth:   [12]string{"leden", "únor", "březen", "duben", "květen", "červen", "červenec", "srpen", "září", "říjen", "listopad", "prosinec"},
+	},
+	{
+		Tag:        language.MustParse("cu"),
+		DTfmt:      "%Y %b %-d %H:%M:%S",
+		Dfmt:       "%Y.%m.%d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y.%m.%d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"ДП", "ПП"},
+		AbDay:      [7]string{"ндⷧ҇ѧ", "пнⷣе", "втоⷬ҇", "срⷣе", "чеⷦ҇", "пѧⷦ҇", "сꙋⷠ҇"},
+		Day:        [7]string{"недѣ́лѧ", "понедѣ́льникъ", "вто́рникъ", "среда̀", "четверто́къ", "пѧто́къ", "сꙋббѡ́та"},
+		AbMonth:    [12]string{"і҆аⷩ҇", "феⷡ҇", "маⷬ҇", "а҆пⷬ҇", "маꙵ", "і҆ꙋⷩ҇", "і҆ꙋⷧ҇", "а҆́ѵⷢ҇", "сеⷫ҇", "ѻ҆кⷮ", "ноеⷨ", "деⷦ҇"},
+		Month:      [12]string{"і҆аннꙋа́рїа", "феврꙋа́рїа", "ма́рта", "а҆прі́ллїа", "ма́їа", "і҆ꙋ́нїа", "і҆ꙋ́лїа", "а҆́ѵгꙋста", "септе́мврїа", "ѻ҆ктѡ́врїа", "ное́мврїа", "деке́мврїа"},
+		AbAltMonth: [12]string{"і҆аⷩ҇", "феⷡ҇", "маⷬ҇", "а҆пⷬ҇", "маꙵ", "і҆ꙋⷩ҇", "і҆ꙋⷧ҇", "а҆́ѵⷢ҇", "сеⷫ҇", "ѻ҆кⷮ", "ноеⷨ", "деⷦ҇"},
+		AltMonth:   [12]string{"і҆аннꙋа́рїй", "феврꙋа́рїй", "ма́ртъ", "а҆прі́ллїй", "ма́їй", "і҆ꙋ́нїй", "і҆ꙋ́лїй", "а҆́ѵгꙋстъ", "септе́мврїй", "ѻ҆ктѡ́врїй", "ное́мврїй", "деке́мврїй"},
+	},
+	{
+		Tag:        language.MustParse("cy"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"yb", "yh"},
+		AbDay:      [7]string{"Sul", "Llun", "Maw", "Mer", "Iau", "Gwen", "Sad"},
+		Day:        [7]string{"Dydd Sul", "Dydd Llun", "Dydd Mawrth", "Dydd Mercher", "Dydd Iau", "Dydd Gwener", "Dydd Sadwrn"},
+		AbMonth:    [12]string{"Ion", "Chwef", "Maw", "Ebrill", "Mai", "Meh", "Gorff", "Awst", "Medi", "Hyd", "Tach", "Rhag"},
+		Month:      [12]string{"Ionawr", "Chwefror", "Mawrth", "Ebrill", "Mai", "Mehefin", "Gorffennaf", "Awst", "Medi", "Hydref", "Tachwedd", "Rhagfyr"},
+		AbAltMonth: [12]string{"Ion", "Chw", "Maw", "Ebr", "Mai", "Meh", "Gor", "Awst", "Medi", "Hyd", "Tach", "Rhag"},
+		AltMonth:   [12]string{"Ionawr", "Chwefror", "Mawrth", "Ebrill", "Mai", "Mehefin", "Gorffennaf", "Awst", "Medi", "Hydref", "Tachwedd", "Rhagfyr"},
+	},
+	{
+		Tag:       language.MustParse("da"),
+		DTfmt:     "%-d. %b %Y %H.%M.%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H.%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"søn.", "man.", "tir.", "ons.", "tor.", "fre.", "lør."},
+		Day:       [7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+		AbMonth:   [12]string{"jan.", "feb.", "mar.", "apr.", "maj", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("dav"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Luma lwa K", "luma lwa p"},
+		AbDay:     [7]string{"Jum", "Jim", "Kaw", "Kad", "Kan", "Kas", "Ngu"},
+		Day:       [7]string{"Ituku ja jumwa", "Kuramuka jimweri", "Kuramuka kawi", "Kuramuka kadadu", "Kuramuka kana", "Kuramuka kasanu", "Kifula nguwo"},
+		AbMonth:   [12]string{"Imb", "Kaw", "Kad", "Kan", "Kas", "Kar", "Mfu", "Wun", "Ike", "Iku", "Imw", "Iwi"},
+		Month:     [12]string{"Mori ghwa imbiri", "Mori ghwa kawi", "Mori ghwa kadadu", "Mori ghwa kana", "Mori ghwa kasanu", "Mori ghwa karandadu", "Mori ghwa mfungade", "Mori ghwa wunyanya", "Mori ghwa ikenda", "Mori ghwa ikumi", "Mori ghwa ikumi na imweri", "Mori ghwa ikumi na iwi"},
+	},
+	{
+		Tag:        language.MustParse("de-AT"),
+		DTfmt:      "%d.%m.%Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		Day:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		AbMonth:    [12]string{"Jän.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."},
+		Month:      [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		AbAltMonth: [12]string{"Jän", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		AltMonth:   [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	},
+	{
+		Tag:        language.MustParse("de-IT"),
+		DTfmt:      "%d.%m.%Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		Day:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		AbMonth:    [12]string{"Jän.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."},
+		Month:      [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		AbAltMonth: [12]string{"Jän", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		AltMonth:   [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	},
+	{
+		Tag:        language.MustParse("de"),
+		DTfmt:      "%d.%m.%Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		Day:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		AbMonth:    [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."},
+		Month:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		AbAltMonth: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		AltMonth:   [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	},
+	{
+		Tag:       language.MustParse("dje"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Subbaahi", "Zaarikay b"},
+		AbDay:     [7]string{"Alh", "Ati", "Ata", "Ala", "Alm", "Alz", "Asi"},
+		Day:       [7]string{"Alhadi", "Atinni", "Atalaata", "Alarba", "Alhamisi", "Alzuma", "Asibti"},
+		AbMonth:   [12]string{"Žan", "Fee", "Mar", "Awi", "Me", "Žuw", "Žuy", "Ut", "Sek", "Okt", "Noo", "Dee"},
+		Month:     [12]string{"Žanwiye", "Feewiriye", "Marsi", "Awiril", "Me", "Žuweŋ", "Žuyye", "Ut", "Sektanbur", "Oktoobur", "Noowanbur", "Deesanbur"},
+	},
+	{
+		Tag:        language.MustParse("dsb"),
+		DTfmt:      "%-d.%-m.%Y %-H:%M:%S",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"dopołdnja", "wótpołdnja"},
+		AbDay:      [7]string{"nje", "pón", "wał", "srj", "stw", "pět", "sob"},
+		Day:        [7]string{"njeźela", "pónjeźele", "wałtora", "srjoda", "stwórtk", "pětk", "sobota"},
+		AbMonth:    [12]string{"jan.", "feb.", "měr.", "apr.", "maj.", "jun.", "jul.", "awg.", "sep.", "okt.", "now.", "dec."},
+		Month:      [12]string{"januara", "februara", "měrca", "apryla", "maja", "junija", "julija", "awgusta", "septembra", "oktobra", "nowembra", "decembra"},
+		AbAltMonth: [12]string{"jan", "feb", "měr", "apr", "maj", "jun", "jul", "awg", "sep", "okt", "now", "dec"},
+		AltMonth:   [12]string{"januar", "februar", "měrc", "apryl", "maj", "junij", "julij", "awgust", "september", "oktober", "nowember", "december"},
+	},
+	{
+		Tag:       language.MustParse("dua"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"idiɓa", "ebyámu"},
+		AbDay:     [7]string{"ét", "mɔ́s", "kwa", "muk", "ŋgi", "ɗón", "esa"},
+		Day:       [7]string{"éti", "mɔ́sú", "kwasú", "mukɔ́sú", "ŋgisú", "ɗónɛsú", "esaɓasú"},
+		AbMonth:   [12]string{"di", "ŋgɔn", "sɔŋ", "diɓ", "emi", "esɔ", "mad", "diŋ", "nyɛt", "may", "tin", "elá"},
+		Month:     [12]string{"dimɔ́di", "ŋgɔndɛ", "sɔŋɛ", "diɓáɓá", "emiasele", "esɔpɛsɔpɛ", "madiɓɛ́díɓɛ́", "diŋgindi", "nyɛtɛki", "mayésɛ́", "tiníní", "eláŋgɛ́"},
+	},
+	{
+		Tag:       language.MustParse("dyo"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Dim", "Ten", "Tal", "Ala", "Ara", "Arj", "Sib"},
+		Day:       [7]string{"Dimas", "Teneŋ", "Talata", "Alarbay", "Aramisay", "Arjuma", "Sibiti"},
+		AbMonth:   [12]string{"Sa", "Fe", "Ma", "Ab", "Me", "Su", "Sú", "Ut", "Se", "Ok", "No", "De"},
+		Month:     [12]string{"Sanvie", "Fébirie", "Mars", "Aburil", "Mee", "Sueŋ", "Súuyee", "Ut", "Settembar", "Oktobar", "Novembar", "Disambar"},
+	},
+	{
+		Tag:        language.MustParse("dz"),
+		DTfmt:      "སྤྱི་ལོ་%Y ཟླ་%b ཚེས་%d ཆུ་ཚོད་%-I:%M:%S %p",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "ཆུ་ཚོད་%-I:%M:%S %p",
+		Tfmt12:     "ཆུ་ཚོད་%-I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "ཆུ་ཚོད་ %-I སྐར་མ་ %M %p",
+		AmPm:       [2]string{"སྔ་ཆ་", "ཕྱི་ཆ་"},
+		AbDay:      [7]string{"ཟླ་", "མིར་", "ལྷག་", "ཕུར་", "སངས་", "སྤེན་", "ཉི་"},
+		Day:        [7]string{"གཟའ་ཟླ་བ་", "གཟའ་མིག་དམར་", "གཟའ་ལྷག་པ་", "གཟའ་ཕུར་བུ་", "གཟའ་པ་སངས་", "གཟའ་སྤེན་པ་", "གཟའ་ཉི་མ་"},
+		AbMonth:    [12]string{"༡", "༢", "༣", "༤", "༥", "༦", "༧", "༨", "༩", "༡༠", "༡༡", "12"},
+		Month:      [12]string{"ཟླ་དངཔ་", "ཟླ་གཉིས་པ་", "ཟླ་གསུམ་པ་", "ཟླ་བཞི་པ་", "ཟླ་ལྔ་པ་", "ཟླ་དྲུག་པ", "ཟླ་བདུན་པ་", "ཟླ་བརྒྱད་པ་", "ཟླ་དགུ་པ་", "ཟླ་བཅུ་པ་", "ཟླ་བཅུ་གཅིག་པ་", "ཟླ་བཅུ་གཉིས་པ་"},
+		AbAltMonth: [12]string{"ཟླ་༡", "ཟླ་༢", "ཟླ་༣", "ཟླ་༤", "ཟླ་༥", "ཟླ་༦", "ཟླ་༧", "ཟླ་༨", "ཟླ་༩", "ཟླ་༡༠", "ཟླ་༡༡", "ཟླ་༡༢"},
+		AltMonth:   [12]string{"སྤྱི་ཟླ་དངཔ་", "སྤྱི་ཟླ་གཉིས་པ་", "སྤྱི་ཟླ་གསུམ་པ་", "སྤྱི་ཟླ་བཞི་པ", "སྤྱི་ཟླ་ལྔ་པ་", "སྤྱི་ཟླ་དྲུག་པ", "སྤྱི་ཟླ་བདུན་པ་", "སྤྱི་ཟླ་བརྒྱད་པ་", "སྤྱི་ཟླ་དགུ་པ་", "སྤྱི་ཟླ་བཅུ་པ་", "སྤྱི་ཟླ་བཅུ་གཅིག་པ་", "སྤྱི་ཟླ་བཅུ་གཉིས་པ་"},
+	},
+	{
+		Tag:       language.MustParse("ebu"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"KI", "UT"},
+		AbDay:     [7]string{"Kma", "Tat", "Ine", "Tan", "Arm", "Maa", "NMM"},
+		Day:       [7]string{"Kiumia", "Njumatatu", "Njumaine", "Njumatano", "Aramithi", "Njumaa", "NJumamothii"},
+		AbMonth:   [12]string{"Mbe", "Kai", "Kat", "Kan", "Gat", "Gan", "Mug", "Knn", "Ken", "Iku", "Imw", "Igi"},
+		Month:     [12]string{"Mweri wa mbere", "Mweri wa kaĩri", "Mweri wa kathatũ", "Mweri wa kana", "Mweri wa gatano", "Mweri wa gatantatũ", "Mweri wa mũgwanja", "Mweri wa kanana", "Mweri wa kenda", "Mweri wa ikũmi", "Mweri wa ikũmi na ũmwe", "Mweri wa ikũmi na Kaĩrĩ"},
+	},
+	{
+		Tag:       language.MustParse("ee-TG"),
+		DTfmt:     "%H:%M:%S %b %-d lia, %Y",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ŋdi", "ɣetrɔ"},
+		AbDay:     [7]string{"kɔs", "dzo", "bla", "kuɖ", "yaw", "fiɖ", "mem"},
+		Day:       [7]string{"kɔsiɖa", "dzoɖa", "blaɖa", "kuɖa", "yawoɖa", "fiɖa", "memleɖa"},
+		AbMonth:   [12]string{"dzv", "dzd", "ted", "afɔ", "dam", "mas", "sia", "dea", "any", "kel", "ade", "dzm"},
+		Month:     [12]string{"dzove", "dzodze", "tedoxe", "afɔfĩe", "dama", "masa", "siamlɔm", "deasiamime", "anyɔnyɔ", "kele", "adeɛmekpɔxe", "dzome"},
+	},
+	{
+		Tag:       language.MustParse("ee"),
+		DTfmt:     "%p ga %-I:%M:%S %b %-d lia, %Y",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%p ga %-I:%M:%S",
+		Tfmt12:    "%p ga %-I:%M:%S",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%p ga %-I:%M",
+		AmPm:      [2]string{"ŋdi", "ɣetrɔ"},
+		AbDay:     [7]string{"kɔs", "dzo", "bla", "kuɖ", "yaw", "fiɖ", "mem"},
+		Day:       [7]string{"kɔsiɖa", "dzoɖa", "blaɖa", "kuɖa", "yawoɖa", "fiɖa", "memleɖa"},
+		AbMonth:   [12]string{"dzv", "dzd", "ted", "afɔ", "dam", "mas", "sia", "dea", "any", "kel", "ade", "dzm"},
+		Month:     [12]string{"dzove", "dzodze", "tedoxe", "afɔfĩe", "dama", "masa", "siamlɔm", "deasiamime", "anyɔnyɔ", "kele", "adeɛmekpɔxe", "dzome"},
+	},
+	{
+		Tag:        language.MustParse("el"),
+		DTfmt:      "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"π.μ.", "μ.μ."},
+		AbDay:      [7]string{"Κυρ", "Δευ", "Τρί", "Τετ", "Πέμ", "Παρ", "Σάβ"},
+		Day:        [7]string{"Κυριακή", "Δευτέρα", "Τρίτη", "Τετάρτη", "Πέμπτη", "Παρασκευή", "Σάββατο"},
+		AbMonth:    [12]string{"Ιαν", "Φεβ", "Μαρ", "Απρ", "Μαΐ", "Ιουν", "Ιουλ", "Αυγ", "Σεπ", "Οκτ", "Νοε", "Δεκ"},
+		Month:      [12]string{"Ιανουαρίου", "Φεβρουαρίου", "Μαρτίου", "Απριλίου", "Μαΐου", "Ιουνίου", "Ιουλίου", "Αυγούστου", "Σεπτεμβρίου", "Οκτωβρίου", "Νοεμβρίου", "Δεκεμβρίου"},
+		AbAltMonth: [12]string{"Ιαν", "Φεβ", "Μάρ", "Απρ", "Μάι", "Ιούν", "Ιούλ", "Αύγ", "Σεπ", "Οκτ", "Νοέ", "Δεκ"},
+		AltMonth:   [12]string{"Ιανουάριος", "Φεβρουάριος", "Μάρτιος", "Απρίλιος", "Μάιος", "Ιούνιος", "Ιούλιος", "Αύγουστος", "Σεπτέμβριος", "Οκτώβριος", "Νοέμβριος", "Δεκέμβριος"},
+	},
+	{
+		Tag:       language.MustParse("en-001"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-150"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-AI"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-AU"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"am", "pm"},
+		AbDay:     [7]string{"Sun.", "Mon.", "Tue.", "Wed.", "Thu.", "Fri.", "Sat."},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan.", "Feb.", "Mar.", "Apr.", "May", "Jun.", "Jul.", "Aug.", "Sep.", "Oct.", "Nov.", "Dec."},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-BE"),
+		DTfmt:     "%d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-BW"),
+		DTfmt:     "%d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-BZ"),
+		DTfmt:     "%d-%b-%Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:        language.MustParse("en-CA"),
+		DTfmt:      "%b %-d, %Y, %-I:%M:%S %p",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"a.m.", "p.m."},
+		AbDay:      [7]string{"Sun.", "Mon.", "Tue.", "Wed.", "Thu.", "Fri.", "Sat."},
+		Day:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:    [12]string{"Jan.", "Feb.", "Mar.", "Apr.", "May", "Jun.", "Jul.", "Aug.", "Sep.", "Oct.", "Nov.", "Dec."},
+		Month:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		AbAltMonth: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		AltMonth:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-CC"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-CK"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-CM"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-CX"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-DG"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-DK"),
+		DTfmt:     "%-d %b %Y, %H.%M.%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H.%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-FI"),
+		DTfmt:     "%-d %b %Y, %-H.%M.%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-H.%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-FK"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-GB"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"am", "pm"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-GG"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-GI"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-HK"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-IE"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-IL"),
+		DTfmt:     "%-d %b %Y, %-H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-IM"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-IN"),
+		DTfmt:     "%d-%b-%Y, %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-IO"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-JE"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-JM"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-KE"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-MG"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-MS"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-MT"),
+		DTfmt:     "%d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-MU"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-NF"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-NR"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-NU"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-NZ"),
+		DTfmt:     "%-d/%m/%Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-PK"),
+		DTfmt:     "%d-%b-%Y, %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-PN"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-RW"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-SC"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-SE"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-SG"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-SH"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-SX"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-TK"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-TV"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-TZ"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-UG"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-ZA"),
+		DTfmt:     "%d %b %Y, %H:%M:%S",
+		Dfmt:      "%Y/%m/%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y/%m/%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en-ZW"),
+		DTfmt:     "%d %b,%Y, %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("en"),
+		DTfmt:     "%b %-d, %Y, %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		Tag:       language.MustParse("eo"),
+		DTfmt:     "%Y-%b-%d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"atm", "ptm"},
+		AbDay:     [7]string{"di", "lu", "ma", "me", "ĵa", "ve", "sa"},
+		Day:       [7]string{"dimanĉo", "lundo", "mardo", "merkredo", "ĵaŭdo", "vendredo", "sabato"},
+		AbMonth:   [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aŭg", "sep", "okt", "nov", "dec"},
+		Month:     [12]string{"januaro", "februaro", "marto", "aprilo", "majo", "junio", "julio", "aŭgusto", "septembro", "oktobro", "novembro", "decembro"},
+	},
+	{
+		Tag:       language.MustParse("es-419"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-AR"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-BO"),
+		DTfmt:     "%-d %b de %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:        language.MustParse("es-CL"),
+		DTfmt:      "%d-%m-%Y %H:%M:%S",
+		Dfmt:       "%d-%m-%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d-%m-%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:    [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		AbAltMonth: [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		AltMonth:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:        language.MustParse("es-CO"),
+		DTfmt:      "%-d/%m/%Y, %-I:%M:%S %p",
+		Dfmt:       "%-d/%m/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d/%m/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:    [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		AbAltMonth: [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		AltMonth:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-CR"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-DO"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-EC"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-GT"),
+		DTfmt:     "%-d/%m/%Y %H:%M:%S",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-HN"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:        language.MustParse("es-MX"),
+		DTfmt:      "%-d %b %Y %-H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:    [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		Month:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		AbAltMonth: [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		AltMonth:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-NI"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-PA"),
+		DTfmt:     "%m/%d/%Y %-I:%M:%S %p",
+		Dfmt:      "%m/%d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%m/%d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:        language.MustParse("es-PE"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%-d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:    [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "set.", "oct.", "nov.", "dic."},
+		Month:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "setiembre", "octubre", "noviembre", "diciembre"},
+		AbAltMonth: [12]string{"Ene.", "Feb.", "Mar.", "Abr.", "May.", "Jun.", "Jul.", "Ago.", "Set.", "Oct.", "Nov.", "Dic."},
+		AltMonth:   [12]string{"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto", "Setiembre", "Octubre", "Noviembre", "Diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-PH"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-PR"),
+		DTfmt:     "%m/%d/%Y %-I:%M:%S %p",
+		Dfmt:      "%m/%d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%m/%d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-PY"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-SV"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-US"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sep.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:        language.MustParse("es-UY"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"a. m.", "p. m."},
+		AbDay:      [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:    [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "set.", "oct.", "nov.", "dic."},
+		Month:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "setiembre", "octubre", "noviembre", "diciembre"},
+		AbAltMonth: [12]string{"Ene.", "Feb.", "Mar.", "Abr.", "May.", "Jun.", "Jul.", "Ago.", "Set.", "Oct.", "Nov.", "Dic."},
+		AltMonth:   [12]string{"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto", "Setiembre", "Octubre", "Noviembre", "Diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es-VE"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("es"),
+		DTfmt:     "%-d %b %Y %-H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"a. m.", "p. m."},
+		AbDay:     [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		Day:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbMonth:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+		Month:     [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	},
+	{
+		Tag:       language.MustParse("et"),
+		DTfmt:     "%-d. %b %Y %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"P", "E", "T", "K", "N", "R", "L"},
+		Day:       [7]string{"pühapäev", "esmaspäev", "teisipäev", "kolmapäev", "neljapäev", "reede", "laupäev"},
+		AbMonth:   [12]string{"jaan", "veebr", "märts", "apr", "mai", "juuni", "juuli", "aug", "sept", "okt", "nov", "dets"},
+		Month:     [12]string{"jaanuar", "veebruar", "märts", "aprill", "mai", "juuni", "juuli", "august", "september", "oktoober", "november", "detsember"},
+	},
+	{
+		Tag:        language.MustParse("eu"),
+		DTfmt:      "%Y %b %-d %H:%M:%S",
+		Dfmt:       "%Y/%-m/%-d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y/%-m/%-d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"ig.", "al.", "ar.", "az.", "og.", "or.", "lr."},
+		Day:        [7]string{"igandea", "astelehena", "asteartea", "asteazkena", "osteguna", "ostirala", "larunbata"},
+		AbMonth:    [12]string{"urt.", "ots.", "mar.", "api.", "mai.", "eka.", "uzt.", "abu.", "ira.", "urr.", "aza.", "abe."},
+		Month:      [12]string{"urtarrila", "otsaila", "martxoa", "apirila", "maiatza", "ekaina", "uztaila", "abuztua", "iraila", "urria", "azaroa", "abendua"},
+		AbAltMonth: [12]string{"urt.", "ots.", "mar.", "api.", "mai.", "eka.", "uzt.", "abu.", "ira.", "urr.", "aza.", "abe."},
+		AltMonth:   [12]string{"urtarrila", "Otsaila", "Martxoa", "Apirila", "Maiatza", "Ekaina", "Uztaila", "Abuztua", "Iraila", "Urria", "Azaroa", "Abendua"},
+	},
+	{
+		Tag:       language.MustParse("ewo"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"kíkíríg", "ngəgógəle"},
+		AbDay:     [7]string{"sɔ́n", "mɔ́n", "smb", "sml", "smn", "fúl", "sér"},
+		Day:       [7]string{"sɔ́ndɔ", "mɔ́ndi", "sɔ́ndɔ məlú mə́bɛ̌", "sɔ́ndɔ məlú mə́lɛ́", "sɔ́ndɔ məlú mə́nyi", "fúladé", "séradé"},
+		AbMonth:   [12]string{"ngo", "ngb", "ngl", "ngn", "ngt", "ngs", "ngz", "ngm", "nge", "nga", "ngad", "ngab"},
+		Month:     [12]string{"ngɔn osú", "ngɔn bɛ̌", "ngɔn lála", "ngɔn nyina", "ngɔn tána", "ngɔn saməna", "ngɔn zamgbála", "ngɔn mwom", "ngɔn ebulú", "ngɔn awóm", "ngɔn awóm ai dziá", "ngɔn awóm ai bɛ̌"},
+	},
+	{
+		Tag:        language.MustParse("fa-AF"),
+		DTfmt:      "%-d %b %Y،\u200f %-H:%M:%S",
+		Dfmt:       "%Y/%-m/%-d",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y/%-m/%-d",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"ق.ظ.", "ب.ظ."},
+		AbDay:      [7]string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		Day:        [7]string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		AbMonth:    [12]string{"جنو", "فبروری", "مارچ", "اپریل", "می", "جون", "جول", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسم"},
+		Month:      [12]string{"جنوری", "فبروری", "مارچ", "اپریل", "می", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+		AbAltMonth: [12]string{"جنوری", "فبروری", "مارچ", "اپریل", "می", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+		AltMonth:   [12]string{"جنوری", "فبروری", "مارچ", "اپریل", "می", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:        language.MustParse("fa"),
+		DTfmt:      "%-d %b %Y،\u200f %-H:%M:%S",
+		Dfmt:       "%Y/%-m/%-d",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y/%-m/%-d",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"ق.ظ.", "ب.ظ."},
+		AbDay:      [7]string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		Day:        [7]string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		AbMonth:    [12]string{"ژانویهٔ", "فوریهٔ", "مارس", "آوریل", "مهٔ", "ژوئن", "ژوئیهٔ", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+		Month:      [12]string{"ژانویهٔ", "فوریهٔ", "مارس", "آوریل", "مهٔ", "ژوئن", "ژوئیهٔ", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+		AbAltMonth: [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+		AltMonth:   [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+	},
+	{
+		Tag:       language.MustParse("ff-MR"),
+		DTfmt:     "%-d %b, %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"subaka", "kikiiɗe"},
+		AbDay:     [7]string{"dew", "aaɓ", "maw", "nje", "naa", "mwd", "hbi"},
+		Day:       [7]string{"dewo", "aaɓnde", "mawbaare", "njeslaare", "naasaande", "mawnde", "hoore-biir"},
+		AbMonth:   [12]string{"sii", "col", "mbo", "see", "duu", "kor", "mor", "juk", "slt", "yar", "jol", "bow"},
+		Month:     [12]string{"siilo", "colte", "mbooy", "seeɗto", "duujal", "korse", "morso", "juko", "siilto", "yarkomaa", "jolal", "bowte"},
+	},
+	{
+		Tag:       language.MustParse("ff"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"subaka", "kikiiɗe"},
+		AbDay:     [7]string{"dew", "aaɓ", "maw", "nje", "naa", "mwd", "hbi"},
+		Day:       [7]string{"dewo", "aaɓnde", "mawbaare", "njeslaare", "naasaande", "mawnde", "hoore-biir"},
+		AbMonth:   [12]string{"sii", "col", "mbo", "see", "duu", "kor", "mor", "juk", "slt", "yar", "jol", "bow"},
+		Month:     [12]string{"siilo", "colte", "mbooy", "seeɗto", "duujal", "korse", "morso", "juko", "siilto", "yarkomaa", "jolal", "bowte"},
+	},
+	{
+		Tag:        language.MustParse("fi"),
+		DTfmt:      "%-d.%-m.%Y klo %-H.%M.%S",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%-H.%M.%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%-H.%M",
+		AmPm:       [2]string{"ap.", "ip."},
+		AbDay:      [7]string{"su", "ma", "ti", "ke", "to", "pe", "la"},
+		Day:        [7]string{"sunnuntaina", "maanantaina", "tiistaina", "keskiviikkona", "torstaina", "perjantaina", "lauantaina"},
+		AbMonth:    [12]string{"tammik.", "helmik.", "maalisk.", "huhtik.", "toukok.", "kesäk.", "heinäk.", "elok.", "syysk.", "lokak.", "marrask.", "jouluk."},
+		Month:      [12]string{"tammikuuta", "helmikuuta", "maaliskuuta", "huhtikuuta", "toukokuuta", "kesäkuuta", "heinäkuuta", "elokuuta", "syyskuuta", "lokakuuta", "marraskuuta", "joulukuuta"},
+		AbAltMonth: [12]string{"tammi", "helmi", "maalis", "huhti", "touko", "kesä", "heinä", "elo", "syys", "loka", "marras", "joulu"},
+		AltMonth:   [12]string{"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu"},
+	},
+	{
+		Tag:       language.MustParse("fil"),
+		DTfmt:     "%b %-d, %Y, %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Lin", "Lun", "Mar", "Miy", "Huw", "Biy", "Sab"},
+		Day:       [7]string{"Linggo", "Lunes", "Martes", "Miyerkules", "Huwebes", "Biyernes", "Sabado"},
+		AbMonth:   [12]string{"Ene", "Peb", "Mar", "Abr", "May", "Hun", "Hul", "Ago", "Set", "Okt", "Nob", "Dis"},
+		Month:     [12]string{"Enero", "Pebrero", "Marso", "Abril", "Mayo", "Hunyo", "Hulyo", "Agosto", "Setyembre", "Oktubre", "Nobyembre", "Disyembre"},
+	},
+	{
+		Tag:        language.MustParse("fo"),
+		DTfmt:      "%d.%m.%Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"sun.", "mán.", "týs.", "mik.", "hós.", "frí.", "ley."},
+		Day:        [7]string{"sunnudagur", "mánadagur", "týsdagur", "mikudagur", "hósdagur", "fríggjadagur", "leygardagur"},
+		AbMonth:    [12]string{"jan.", "feb.", "mar.", "apr.", "mai", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "des."},
+		Month:      [12]string{"januar", "februar", "mars", "apríl", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+		AbAltMonth: [12]string{"jan", "feb", "mar", "apr", "mai", "jun", "jul", "aug", "sep", "okt", "nov", "des"},
+		AltMonth:   [12]string{"januar", "februar", "mars", "apríl", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+	},
+	{
+		Tag:       language.MustParse("fr-BE"),
+		DTfmt:     "%-d %b %Y à %H:%M:%S",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-CA"),
+		DTfmt:     "%-d %b %Y %H h %M min %S s",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H h %M min %S s",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H h %M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juill.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-CH"),
+		DTfmt:     "%-d %b %Y à %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-CM"),
+		DTfmt:     "%-d %b %Y à %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"mat.", "soir"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-DJ"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-DZ"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-MA"),
+		DTfmt:     "%-d %b %Y à %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"jan.", "fév.", "mar.", "avr.", "mai", "jui.", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-ML"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-MR"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-SY"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-TD"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-TN"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr-VU"),
+		DTfmt:     "%-d %b %Y à %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fr"),
+		DTfmt:     "%-d %b %Y à %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		Day:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbMonth:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Month:     [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	},
+	{
+		Tag:       language.MustParse("fur"),
+		DTfmt:     "%d/%m/%Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.", "p."},
+		AbDay:     [7]string{"dom", "lun", "mar", "mie", "joi", "vin", "sab"},
+		Day:       [7]string{"domenie", "lunis", "martars", "miercus", "joibe", "vinars", "sabide"},
+		AbMonth:   [12]string{"Zen", "Fev", "Mar", "Avr", "Mai", "Jug", "Lui", "Avo", "Set", "Otu", "Nov", "Dic"},
+		Month:     [12]string{"Zenâr", "Fevrâr", "Març", "Avrîl", "Mai", "Jugn", "Lui", "Avost", "Setembar", "Otubar", "Novembar", "Dicembar"},
+	},
+	{
+		Tag:       language.MustParse("fy"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"si", "mo", "ti", "wo", "to", "fr", "so"},
+		Day:       [7]string{"snein", "moandei", "tiisdei", "woansdei", "tongersdei", "freed", "sneon"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mrt", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Jannewaris", "Febrewaris", "Maart", "April", "Maaie", "Juny", "July", "Augustus", "Septimber", "Oktober", "Novimber", "Desimber"},
+	},
+	{
+		Tag:       language.MustParse("ga"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"r.n.", "i.n."},
+		AbDay:     [7]string{"Domh", "Luan", "Máirt", "Céad", "Déar", "Aoine", "Sath"},
+		Day:       [7]string{"Dé Domhnaigh", "Dé Luain", "Dé Máirt", "Dé Céadaoin", "Déardaoin", "Dé hAoine", "Dé Sathairn"},
+		AbMonth:   [12]string{"Ean", "Feabh", "Márta", "Aib", "Beal", "Meith", "Iúil", "Lún", "MFómh", "DFómh", "Samh", "Noll"},
+		Month:     [12]string{"Eanáir", "Feabhra", "Márta", "Aibreán", "Bealtaine", "Meitheamh", "Iúil", "Lúnasa", "Meán Fómhair", "Deireadh Fómhair", "Samhain", "Nollaig"},
+	},
+	{
+		Tag:        language.MustParse("gd"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"m", "f"},
+		AbDay:      [7]string{"DiD", "DiL", "DiM", "DiC", "Dia", "Dih", "DiS"},
+		Day:        [7]string{"DiDòmhnaich", "DiLuain", "DiMàirt", "DiCiadain", "DiarDaoin", "DihAoine", "DiSathairne"},
+		AbMonth:    [12]string{"Faoi", "Gearr", "Màrt", "Gibl", "Cèit", "Ògmh", "Iuch", "Lùna", "Sult", "Dàmh", "Samh", "Dùbh"},
+		Month:      [12]string{"dhen Fhaoilleach", "dhen Ghearran", "dhen Mhàrt", "dhen Ghiblean", "dhen Chèitean", "dhen Ògmhios", "dhen Iuchar", "dhen Lùnastal", "dhen t-Sultain", "dhen Dàmhair", "dhen t-Samhain", "dhen Dùbhlachd"},
+		AbAltMonth: [12]string{"Faoi", "Gearr", "Màrt", "Gibl", "Cèit", "Ògmh", "Iuch", "Lùna", "Sult", "Dàmh", "Samh", "Dùbh"},
+		AltMonth:   [12]string{"Am Faoilleach", "An Gearran", "Am Màrt", "An Giblean", "An Cèitean", "An t-Ògmhios", "An t-Iuchar", "An Lùnastal", "An t-Sultain", "An Dàmhair", "An t-Samhain", "An Dùbhlachd"},
+	},
+	{
+		Tag:        language.MustParse("gl"),
+		DTfmt:      "%H:%M:%S, %d/%m/%Y",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"a.m.", "p.m."},
+		AbDay:      [7]string{"dom.", "luns", "mar.", "mér.", "xov.", "ven.", "sáb."},
+		Day:        [7]string{"domingo", "luns", "martes", "mércores", "xoves", "venres", "sábado"},
+		AbMonth:    [12]string{"xan.", "feb.", "mar.", "abr.", "maio", "xuño", "xul.", "ago.", "set.", "out.", "nov.", "dec."},
+		Month:      [12]string{"xaneiro", "febreiro", "marzo", "abril", "maio", "xuño", "xullo", "agosto", "setembro", "outubro", "novembro", "decembro"},
+		AbAltMonth: [12]string{"Xan.", "Feb.", "Mar.", "Abr.", "Maio", "Xuño", "Xul.", "Ago.", "Set.", "Out.", "Nov.", "Dec."},
+		AltMonth:   [12]string{"Xaneiro", "Febreiro", "Marzo", "Abril", "Maio", "Xuño", "Xullo", "Agosto", "Setembro", "Outubro", "Novembro", "Decembro"},
+	},
+	{
+		Tag:       language.MustParse("gsw"),
+		DTfmt:     "%d.%m.%Y %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"vorm.", "nam."},
+		AbDay:     [7]string{"Su.", "Mä.", "Zi.", "Mi.", "Du.", "Fr.", "Sa."},
+		Day:       [7]string{"Sunntig", "Määntig", "Ziischtig", "Mittwuch", "Dunschtig", "Friitig", "Samschtig"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		Month:     [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "Auguscht", "Septämber", "Oktoober", "Novämber", "Dezämber"},
+	},
+	{
+		Tag:       language.MustParse("gu"),
+		DTfmt:     "%-d %b, %Y %I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%I:%M:%S %p",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"રવિ", "સોમ", "મંગળ", "બુધ", "ગુરુ", "શુક્ર", "શનિ"},
+		Day:       [7]string{"રવિવાર", "સોમવાર", "મંગળવાર", "બુધવાર", "ગુરુવાર", "શુક્રવાર", "શનિવાર"},
+		AbMonth:   [12]string{"જાન્યુ", "ફેબ્રુ", "માર્ચ", "એપ્રિલ", "મે", "જૂન", "જુલાઈ", "ઑગસ્ટ", "સપ્ટે", "ઑક્ટો", "નવે", "ડિસે"},
+		Month:     [12]string{"જાન્યુઆરી", "ફેબ્રુઆરી", "માર્ચ", "એપ્રિલ", "મે", "જૂન", "જુલાઈ", "ઑગસ્ટ", "સપ્ટેમ્બર", "ઑક્ટોબર", "નવેમ્બર", "ડિસેમ્બર"},
+	},
+	{
+		Tag:       language.MustParse("guz"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Ma", "Mo"},
+		AbDay:     [7]string{"Cpr", "Ctt", "Cmn", "Cmt", "Ars", "Icm", "Est"},
+		Day:       [7]string{"Chumapiri", "Chumatato", "Chumaine", "Chumatano", "Aramisi", "Ichuma", "Esabato"},
+		AbMonth:   [12]string{"Can", "Feb", "Mac", "Apr", "Mei", "Jun", "Cul", "Agt", "Sep", "Okt", "Nob", "Dis"},
+		Month:     [12]string{"Chanuari", "Feburari", "Machi", "Apiriri", "Mei", "Juni", "Chulai", "Agosti", "Septemba", "Okitoba", "Nobemba", "Disemba"},
+	},
+	{
+		Tag:       language.MustParse("gv"),
+		DTfmt:     "%b %d, %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"Jed", "Jel", "Jem", "Jerc", "Jerd", "Jeh", "Jes"},
+		Day:       [7]string{"Jedoonee", "Jelhein", "Jemayrt", "Jercean", "Jerdein", "Jeheiney", "Jesarn"},
+		AbMonth:   [12]string{"J-guer", "T-arree", "Mayrnt", "Avrril", "Boaldyn", "M-souree", "J-souree", "Luanistyn", "M-fouyir", "J-fouyir", "M-Houney", "M-Nollick"},
+		Month:     [12]string{"Jerrey-geuree", "Toshiaght-arree", "Mayrnt", "Averil", "Boaldyn", "Mean-souree", "Jerrey-souree", "Luanistyn", "Mean-fouyir", "Jerrey-fouyir", "Mee Houney", "Mee ny Nollick"},
+	},
+	{
+		Tag:       language.MustParse("ha"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Lah", "Lit", "Tal", "Lar", "Alh", "Jum", "Asa"},
+		Day:       [7]string{"Lahadi", "Litinin", "Talata", "Laraba", "Alhamis", "Jummaʼa", "Asabar"},
+		AbMonth:   [12]string{"Jan", "Fab", "Mar", "Afi", "May", "Yun", "Yul", "Agu", "Sat", "Okt", "Nuw", "Dis"},
+		Month:     [12]string{"Janairu", "Faburairu", "Maris", "Afirilu", "Mayu", "Yuni", "Yuli", "Agusta", "Satumba", "Oktoba", "Nuwamba", "Disamba"},
+	},
+	{
+		Tag:       language.MustParse("haw"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"LP", "P1", "P2", "P3", "P4", "P5", "P6"},
+		Day:       [7]string{"Lāpule", "Poʻakahi", "Poʻalua", "Poʻakolu", "Poʻahā", "Poʻalima", "Poʻaono"},
+		AbMonth:   [12]string{"Ian.", "Pep.", "Mal.", "ʻAp.", "Mei", "Iun.", "Iul.", "ʻAu.", "Kep.", "ʻOk.", "Now.", "Kek."},
+		Month:     [12]string{"Ianuali", "Pepeluali", "Malaki", "ʻApelila", "Mei", "Iune", "Iulai", "ʻAukake", "Kepakemapa", "ʻOkakopa", "Nowemapa", "Kekemapa"},
+	},
+	{
+		Tag:       language.MustParse("he"),
+		DTfmt:     "%-d ב%b %Y, %-H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"לפנה״צ", "אחה״צ"},
+		AbDay:     [7]string{"יום א׳", "יום ב׳", "יום ג׳", "יום ד׳", "יום ה׳", "יום ו׳", "שבת"},
+		Day:       [7]string{"יום ראשון", "יום שני", "יום שלישי", "יום רביעי", "יום חמישי", "יום שישי", "יום שבת"},
+		AbMonth:   [12]string{"ינו׳", "פבר׳", "מרץ", "אפר׳", "מאי", "יוני", "יולי", "אוג׳", "ספט׳", "אוק׳", "נוב׳", "דצמ׳"},
+		Month:     [12]string{"ינואר", "פברואר", "מרץ", "אפריל", "מאי", "יוני", "יולי", "אוגוסט", "ספטמבר", "אוקטובר", "נובמבר", "דצמבר"},
+	},
+	{
+		Tag:       language.MustParse("hi"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"पूर्वाह्न", "अपराह्न"},
+		AbDay:     [7]string{"रवि", "सोम", "मंगल", "बुध", "गुरु", "शुक्र", "शनि"},
+		Day:       [7]string{"रविवार", "सोमवार", "मंगलवार", "बुधवार", "गुरुवार", "शुक्रवार", "शनिवार"},
+		AbMonth:   [12]string{"जन॰", "फ़र॰", "मार्च", "अप्रैल", "मई", "जून", "जुल॰", "अग॰", "सित॰", "अक्तू॰", "नव॰", "दिस॰"},
+		Month:     [12]string{"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून", "जुलाई", "अगस्त", "सितंबर", "अक्तूबर", "नवंबर", "दिसंबर"},
+	},
+	{
+		Tag:        language.MustParse("hr-BA"),
+		DTfmt:      "%-d. %b %Y. %H:%M:%S",
+		Dfmt:       "%-d. %-m. %Y.",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d. %-m. %y.",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"ned", "pon", "uto", "sri", "čet", "pet", "sub"},
+		Day:        [7]string{"nedjelja", "ponedjeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		AbMonth:    [12]string{"sij", "velj", "ožu", "tra", "svi", "lip", "srp", "kol", "ruj", "lis", "stu", "pro"},
+		Month:      [12]string{"siječnja", "veljače", "ožujka", "travnja", "svibnja", "lipnja", "srpnja", "kolovoza", "rujna", "listopada", "studenoga", "prosinca"},
+		AbAltMonth: [12]string{"sij", "velj", "ožu", "tra", "svi", "lip", "srp", "kol", "ruj", "lis", "stu", "pro"},
+		AltMonth:   [12]string{"siječanj", "veljača", "ožujak", "travanj", "svibanj", "lipanj", "srpanj", "kolovoz", "rujan", "listopad", "studeni", "prosinac"},
+	},
+	{
+		Tag:        language.MustParse("hr"),
+		DTfmt:      "%-d. %b %Y. %H:%M:%S",
+		Dfmt:       "%d. %m. %Y.",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d. %m. %y.",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"ned", "pon", "uto", "sri", "čet", "pet", "sub"},
+		Day:        [7]string{"nedjelja", "ponedjeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		AbMonth:    [12]string{"sij", "velj", "ožu", "tra", "svi", "lip", "srp", "kol", "ruj", "lis", "stu", "pro"},
+		Month:      [12]string{"siječnja", "veljače", "ožujka", "travnja", "svibnja", "lipnja", "srpnja", "kolovoza", "rujna", "listopada", "studenoga", "prosinca"},
+		AbAltMonth: [12]string{"sij", "velj", "ožu", "tra", "svi", "lip", "srp", "kol", "ruj", "lis", "stu", "pro"},
+		AltMonth:   [12]string{"siječanj", "veljača", "ožujak", "travanj", "svibanj", "lipanj", "srpanj", "kolovoz", "rujan", "listopad", "studeni", "prosinac"},
+	},
+	{
+		Tag:        language.MustParse("hsb"),
+		DTfmt:      "%-d.%-m.%Y %-H:%M:%S",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%-H:%M hodź.",
+		AmPm:       [2]string{"dopołdnja", "popołdnju"},
+		AbDay:      [7]string{"nje", "pón", "wut", "srj", "štw", "pja", "sob"},
+		Day:        [7]string{"njedźela", "póndźela", "wutora", "srjeda", "štwórtk", "pjatk", "sobota"},
+		AbMonth:    [12]string{"jan.", "feb.", "měr.", "apr.", "mej.", "jun.", "jul.", "awg.", "sep.", "okt.", "now.", "dec."},
+		Month:      [12]string{"januara", "februara", "měrca", "apryla", "meje", "junija", "julija", "awgusta", "septembra", "oktobra", "nowembra", "decembra"},
+		AbAltMonth: [12]string{"jan", "feb", "měr", "apr", "mej", "jun", "jul", "awg", "sep", "okt", "now", "dec"},
+		AltMonth:   [12]string{"januar", "februar", "měrc", "apryl", "meja", "junij", "julij", "awgust", "september", "oktober", "nowember", "december"},
+	},
+	{
+		Tag:       language.MustParse("hu"),
+		DTfmt:     "%Y. %b %-d. %-H:%M:%S",
+		Dfmt:      "%Y. %m. %d.",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y. %m. %d.",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"de.", "du."},
+		AbDay:     [7]string{"V", "H", "K", "Sze", "Cs", "P", "Szo"},
+		Day:       [7]string{"vasárnap", "hétfő", "kedd", "szerda", "csütörtök", "péntek", "szombat"},
+		AbMonth:   [12]string{"jan.", "febr.", "márc.", "ápr.", "máj.", "jún.", "júl.", "aug.", "szept.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"január", "február", "március", "április", "május", "június", "július", "augusztus", "szeptember", "október", "november", "december"},
+	},
+	{
+		Tag:        language.MustParse("hy"),
+		DTfmt:      "%d %b, %Y թ., %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"ԿԱ", "ԿՀ"},
+		AbDay:      [7]string{"կիր", "երկ", "երք", "չրք", "հնգ", "ուր", "շբթ"},
+		Day:        [7]string{"կիրակի", "երկուշաբթի", "երեքշաբթի", "չորեքշաբթի", "հինգշաբթի", "ուրբաթ", "շաբաթ"},
+		AbMonth:    [12]string{"հնվ", "փտվ", "մրտ", "ապր", "մյս", "հնս", "հլս", "օգս", "սեպ", "հոկ", "նոյ", "դեկ"},
+		Month:      [12]string{"հունվարի", "փետրվարի", "մարտի", "ապրիլի", "մայիսի", "հունիսի", "հուլիսի", "օգոստոսի", "սեպտեմբերի", "հոկտեմբերի", "նոյեմբերի", "դեկտեմբերի"},
+		AbAltMonth: [12]string{"հնվ", "փտվ", "մրտ", "ապր", "մյս", "հնս", "հլս", "օգս", "սեպ", "հոկ", "նոյ", "դեկ"},
+		AltMonth:   [12]string{"հունվար", "փետրվար", "մարտ", "ապրիլ", "մայիս", "հունիս", "հուլիս", "օգոստոս", "սեպտեմբեր", "հոկտեմբեր", "նոյեմբեր", "դեկտեմբեր"},
+	},
+	{
+		Tag:       language.MustParse("id"),
+		DTfmt:     "%-d %b %Y %H.%M.%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H.%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"},
+		Day:       [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agt", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"},
+	},
+	{
+		Tag:       language.MustParse("ig"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"A.M.", "P.M."},
+		AbDay:     [7]string{"Ụka", "Mọn", "Tiu", "Wen", "Tọọ", "Fraị", "Sat"},
+		Day:       [7]string{"Mbọsị Ụka", "Mọnde", "Tiuzdee", "Wenezdee", "Tọọzdee", "Fraịdee", "Satọdee"},
+		AbMonth:   [12]string{"Jen", "Feb", "Maa", "Epr", "Mee", "Juu", "Jul", "Ọgọ", "Sep", "Ọkt", "Nov", "Dis"},
+		Month:     [12]string{"Jenụwarị", "Febrụwarị", "Maachị", "Eprel", "Mee", "Juun", "Julaị", "Ọgọọst", "Septemba", "Ọktoba", "Novemba", "Disemba"},
+	},
+	{
+		Tag:       language.MustParse("ii"),
+		DTfmt:     "%Y %b %-d %-I:%M:%S %p",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ꎸꄑ", "ꁯꋒ"},
+		AbDay:     [7]string{"ꑭꆏ", "ꆏꋍ", "ꆏꑍ", "ꆏꌕ", "ꆏꇖ", "ꆏꉬ", "ꆏꃘ"},
+		Day:       [7]string{"ꑭꆏꑍ", "ꆏꊂꋍ", "ꆏꊂꑍ", "ꆏꊂꌕ", "ꆏꊂꇖ", "ꆏꊂꉬ", "ꆏꊂꃘ"},
+		AbMonth:   [12]string{"ꋍꆪ", "ꑍꆪ", "ꌕꆪ", "ꇖꆪ", "ꉬꆪ", "ꃘꆪ", "ꏃꆪ", "ꉆꆪ", "ꈬꆪ", "ꊰꆪ", "ꊰꊪꆪ", "ꊰꑋꆪ"},
+		Month:     [12]string{"ꋍꆪ", "ꑍꆪ", "ꌕꆪ", "ꇖꆪ", "ꉬꆪ", "ꃘꆪ", "ꏃꆪ", "ꉆꆪ", "ꈬꆪ", "ꊰꆪ", "ꊰꊪꆪ", "ꊰꑋꆪ"},
+	},
+	{
+		Tag:       language.MustParse("is"),
+		DTfmt:     "%-d. %b %Y, %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"f.h.", "e.h."},
+		AbDay:     [7]string{"sun.", "mán.", "þri.", "mið.", "fim.", "fös.", "lau."},
+		Day:       [7]string{"sunnudagur", "mánudagur", "þriðjudagur", "miðvikudagur", "fimmtudagur", "föstudagur", "laugardagur"},
+		AbMonth:   [12]string{"jan.", "feb.", "mar.", "apr.", "maí", "jún.", "júl.", "ágú.", "sep.", "okt.", "nóv.", "des."},
+		Month:     [12]string{"janúar", "febrúar", "mars", "apríl", "maí", "júní", "júlí", "ágúst", "september", "október", "nóvember", "desember"},
+	},
+	{
+		Tag:       language.MustParse("it-CH"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		Day:       [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		AbMonth:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		Month:     [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	},
+	{
+		Tag:       language.MustParse("it"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		Day:       [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		AbMonth:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		Month:     [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	},
+	{
+		Tag:       language.MustParse("ja"),
+		DTfmt:     "%Y/%m/%d %-H:%M:%S",
+		Dfmt:      "%Y/%m/%d",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y/%m/%d",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"午前", "午後"},
+		AbDay:     [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		Day:       [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	},
+	{
+		Tag:       language.MustParse("jgo"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"mbaꞌmbaꞌ", "ŋka mbɔ́t nji"},
+		AbDay:     [7]string{"Sɔ́ndi", "Mɔ́ndi", "Ápta Mɔ́ndi", "Wɛ́nɛsɛdɛ", "Tɔ́sɛdɛ", "Fɛlâyɛdɛ", "Sásidɛ"},
+		Day:       [7]string{"Sɔ́ndi", "Mɔ́ndi", "Ápta Mɔ́ndi", "Wɛ́nɛsɛdɛ", "Tɔ́sɛdɛ", "Fɛlâyɛdɛ", "Sásidɛ"},
+		AbMonth:   [12]string{"Nduŋmbi Saŋ", "Pɛsaŋ Pɛ́pá", "Pɛsaŋ Pɛ́tát", "Pɛsaŋ Pɛ́nɛ́kwa", "Pɛsaŋ Pataa", "Pɛsaŋ Pɛ́nɛ́ntúkú", "Pɛsaŋ Saambá", "Pɛsaŋ Pɛ́nɛ́fɔm", "Pɛsaŋ Pɛ́nɛ́pfúꞋú", "Pɛsaŋ Nɛgɛ́m", "Pɛsaŋ Ntsɔ̌pmɔ́", "Pɛsaŋ Ntsɔ̌ppá"},
+		Month:     [12]string{"Nduŋmbi Saŋ", "Pɛsaŋ Pɛ́pá", "Pɛsaŋ Pɛ́tát", "Pɛsaŋ Pɛ́nɛ́kwa", "Pɛsaŋ Pataa", "Pɛsaŋ Pɛ́nɛ́ntúkú", "Pɛsaŋ Saambá", "Pɛsaŋ Pɛ́nɛ́fɔm", "Pɛsaŋ Pɛ́nɛ́pfúꞋú", "Pɛsaŋ Nɛgɛ́m", "Pɛsaŋ Ntsɔ̌pmɔ́", "Pɛsaŋ Ntsɔ̌ppá"},
+	},
+	{
+		Tag:       language.MustParse("jmc"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"utuko", "kyiukonyi"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jnn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Jumapilyi", "Jumatatuu", "Jumanne", "Jumatanu", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprilyi", "Mei", "Junyi", "Julyai", "Agusti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("ka"),
+		DTfmt:     "%-d %b. %Y, %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"კვი", "ორშ", "სამ", "ოთხ", "ხუთ", "პარ", "შაბ"},
+		Day:       [7]string{"კვირა", "ორშაბათი", "სამშაბათი", "ოთხშაბათი", "ხუთშაბათი", "პარასკევი", "შაბათი"},
+		AbMonth:   [12]string{"იან", "თებ", "მარ", "აპრ", "მაი", "ივნ", "ივლ", "აგვ", "სექ", "ოქტ", "ნოე", "დეკ"},
+		Month:     [12]string{"იანვარი", "თებერვალი", "მარტი", "აპრილი", "მაისი", "ივნისი", "ივლისი", "აგვისტო", "სექტემბერი", "ოქტომბერი", "ნოემბერი", "დეკემბერი"},
+	},
+	{
+		Tag:        language.MustParse("kab"),
+		DTfmt:      "%-d %b, %Y, %-I:%M:%S %p",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"n tufat", "n tmeddit"},
+		AbDay:      [7]string{"Yan", "San", "Kraḍ", "Kuẓ", "Sam", "Sḍis", "Say"},
+		Day:        [7]string{"Yanass", "Sanass", "Kraḍass", "Kuẓass", "Samass", "Sḍisass", "Sayass"},
+		AbMonth:    [12]string{"Yen", "Fur", "Meɣ", "Yeb", "May", "Yun", "Yul", "Ɣuc", "Cte", "Tub", "Nun", "Duǧ"},
+		Month:      [12]string{"Yennayer", "Fuṛar", "Meɣres", "Yebrir", "Mayyu", "Yunyu", "Yulyu", "Ɣuct", "Ctembeṛ", "Tubeṛ", "Nunembeṛ", "Duǧembeṛ"},
+		AbAltMonth: [12]string{"Yen", "Fur", "Meɣ", "Yeb", "May", "Yun", "Yul", "Ɣuc", "Cte", "Tub", "Wam", "Duj"},
+		AltMonth:   [12]string{"Yennayer", "Fuṛar", "Meɣres", "Yebrir", "Mayyu", "Yunyu", "Yulyu", "Ɣuct", "Ctembeṛ", "Tubeṛ", "Wambeṛ", "Dujembeṛ"},
+	},
+	{
+		Tag:       language.MustParse("kam"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Ĩyakwakya", "Ĩyawĩoo"},
+		AbDay:     [7]string{"Wky", "Wkw", "Wkl", "Wtũ", "Wkn", "Wtn", "Wth"},
+		Day:       [7]string{"Wa kyumwa", "Wa kwambĩlĩlya", "Wa kelĩ", "Wa katatũ", "Wa kana", "Wa katano", "Wa thanthatũ"},
+		AbMonth:   [12]string{"Mbe", "Kel", "Ktũ", "Kan", "Ktn", "Tha", "Moo", "Nya", "Knd", "Ĩku", "Ĩkm", "Ĩkl"},
+		Month:     [12]string{"Mwai wa mbee", "Mwai wa kelĩ", "Mwai wa katatũ", "Mwai wa kana", "Mwai wa katano", "Mwai wa thanthatũ", "Mwai wa muonza", "Mwai wa nyaanya", "Mwai wa kenda", "Mwai wa ĩkumi", "Mwai wa ĩkumi na ĩmwe", "Mwai wa ĩkumi na ilĩ"},
+	},
+	{
+		Tag:       language.MustParse("kde"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Muhi", "Chilo"},
+		AbDay:     [7]string{"Ll2", "Ll3", "Ll4", "Ll5", "Ll6", "Ll7", "Ll1"},
+		Day:       [7]string{"Liduva lyapili", "Liduva lyatatu", "Liduva lyanchechi", "Liduva lyannyano", "Liduva lyannyano na linji", "Liduva lyannyano na mavili", "Liduva litandi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Mwedi Ntandi", "Mwedi wa Pili", "Mwedi wa Tatu", "Mwedi wa Nchechi", "Mwedi wa Nnyano", "Mwedi wa Nnyano na Umo", "Mwedi wa Nnyano na Mivili", "Mwedi wa Nnyano na Mitatu", "Mwedi wa Nnyano na Nchechi", "Mwedi wa Nnyano na Nnyano", "Mwedi wa Nnyano na Nnyano na U", "Mwedi wa Nnyano na Nnyano na M"},
+	},
+	{
+		Tag:       language.MustParse("kea"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"am", "pm"},
+		AbDay:     [7]string{"dum", "sig", "ter", "kua", "kin", "ses", "sab"},
+		Day:       [7]string{"dumingu", "sigunda-fera", "tersa-fera", "kuarta-fera", "kinta-fera", "sesta-fera", "sabadu"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Abr", "Mai", "Jun", "Jul", "Ago", "Set", "Otu", "Nuv", "Diz"},
+		Month:     [12]string{"Janeru", "Febreru", "Marsu", "Abril", "Maiu", "Junhu", "Julhu", "Agostu", "Setenbru", "Otubru", "Nuvenbru", "Dizenbru"},
+	},
+	{
+		Tag:       language.MustParse("khq"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Adduha", "Aluula"},
+		AbDay:     [7]string{"Alh", "Ati", "Ata", "Ala", "Alm", "Alj", "Ass"},
+		Day:       [7]string{"Alhadi", "Atini", "Atalata", "Alarba", "Alhamiisa", "Aljuma", "Assabdu"},
+		AbMonth:   [12]string{"Žan", "Fee", "Mar", "Awi", "Me", "Žuw", "Žuy", "Ut", "Sek", "Okt", "Noo", "Dee"},
+		Month:     [12]string{"Žanwiye", "Feewiriye", "Marsi", "Awiril", "Me", "Žuweŋ", "Žuyye", "Ut", "Sektanbur", "Oktoobur", "Noowanbur", "Deesanbur"},
+	},
+	{
+		Tag:       language.MustParse("ki"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Kiroko", "Hwaĩ-inĩ"},
+		AbDay:     [7]string{"KMA", "NTT", "NMN", "NMT", "ART", "NMA", "NMM"},
+		Day:       [7]string{"Kiumia", "Njumatatũ", "Njumaine", "Njumatana", "Aramithi", "Njumaa", "Njumamothi"},
+		AbMonth:   [12]string{"JEN", "WKR", "WGT", "WKN", "WTN", "WTD", "WMJ", "WNN", "WKD", "WIK", "WMW", "DIT"},
+		Month:     [12]string{"Njenuarĩ", "Mwere wa kerĩ", "Mwere wa gatatũ", "Mwere wa kana", "Mwere wa gatano", "Mwere wa gatandatũ", "Mwere wa mũgwanja", "Mwere wa kanana", "Mwere wa kenda", "Mwere wa ikũmi", "Mwere wa ikũmi na ũmwe", "Ndithemba"},
+	},
+	{
+		Tag:        language.MustParse("kk"),
+		DTfmt:      "%Y ж. %d %b, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"Жс", "Дс", "Сс", "Ср", "Бс", "Жм", "Сб"},
+		Day:        [7]string{"жексенбі", "дүйсенбі", "сейсенбі", "сәрсенбі", "бейсенбі", "жұма", "сенбі"},
+		AbMonth:    [12]string{"қаң.", "ақп.", "нау.", "сәу.", "мам.", "мау.", "шіл.", "там.", "қыр.", "қаз.", "қар.", "жел."},
+		Month:      [12]string{"қаңтар", "ақпан", "наурыз", "сәуір", "мамыр", "маусым", "шілде", "тамыз", "қыркүйек", "қазан", "қараша", "желтоқсан"},
+		AbAltMonth: [12]string{"Қаң.", "Ақп.", "Нау.", "Сәу.", "Мам.", "Мау.", "Шіл.", "Там.", "Қыр.", "Қаз.", "Қар.", "Жел."},
+		AltMonth:   [12]string{"Қаңтар", "Ақпан", "Наурыз", "Сәуір", "Мамыр", "Маусым", "Шілде", "Тамыз", "Қыркүйек", "Қазан", "Қараша", "Желтоқсан"},
+	},
+	{
+		Tag:       language.MustParse("kkj"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m %Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m %y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"sɔndi", "lundi", "mardi", "mɛrkɛrɛdi", "yedi", "vaŋdɛrɛdi", "mɔnɔ sɔndi"},
+		Day:       [7]string{"sɔndi", "lundi", "mardi", "mɛrkɛrɛdi", "yedi", "vaŋdɛrɛdi", "mɔnɔ sɔndi"},
+		AbMonth:   [12]string{"pamba", "wanja", "mbiyɔ mɛndoŋgɔ", "Nyɔlɔmbɔŋgɔ", "Mɔnɔ ŋgbanja", "Nyaŋgwɛ ŋgbanja", "kuŋgwɛ", "fɛ", "njapi", "nyukul", "11", "ɓulɓusɛ"},
+		Month:     [12]string{"pamba", "wanja", "mbiyɔ mɛndoŋgɔ", "Nyɔlɔmbɔŋgɔ", "Mɔnɔ ŋgbanja", "Nyaŋgwɛ ŋgbanja", "kuŋgwɛ", "fɛ", "njapi", "nyukul", "11", "ɓulɓusɛ"},
+	},
+	{
+		Tag:       language.MustParse("kl"),
+		DTfmt:     "%b %d, %Y %H.%M.%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H.%M",
+		AmPm:      [2]string{"u.t.", "u.k."},
+		AbDay:     [7]string{"sab", "ata", "mar", "pin", "sis", "tal", "arf"},
+		Day:       [7]string{"sabaat", "ataasinngorneq", "marlunngorneq", "pingasunngorneq", "sisamanngorneq", "tallimanngorneq", "arfininngorneq"},
+		AbMonth:   [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		Month:     [12]string{"januari", "februari", "martsi", "aprili", "maji", "juni", "juli", "augustusi", "septemberi", "oktoberi", "novemberi", "decemberi"},
+	},
+	{
+		Tag:       language.MustParse("kln"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"krn", "koosk"},
+		AbDay:     [7]string{"Kts", "Kot", "Koo", "Kos", "Koa", "Kom", "Kol"},
+		Day:       [7]string{"Kotisap", "Kotaai", "Koaeng’", "Kosomok", "Koang’wan", "Komuut", "Kolo"},
+		AbMonth:   [12]string{"Mul", "Ngat", "Taa", "Iwo", "Mam", "Paa", "Nge", "Roo", "Bur", "Epe", "Kpt", "Kpa"},
+		Month:     [12]string{"Mulgul", "Ng’atyaato", "Kiptaamo", "Iwootkuut", "Mamuut", "Paagi", "Ng’eiyeet", "Rooptui", "Bureet", "Epeeso", "Kipsuunde ne taai", "Kipsuunde nebo aeng’"},
+	},
+	{
+		Tag:       language.MustParse("km"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"អាទិត្យ", "ច័ន្ទ", "អង្គារ", "ពុធ", "ព្រហស្បតិ៍", "សុក្រ", "សៅរ៍"},
+		Day:       [7]string{"អាទិត្យ", "ច័ន្ទ", "អង្គារ", "ពុធ", "ព្រហស្បតិ៍", "សុក្រ", "សៅរ៍"},
+		AbMonth:   [12]string{"មករា", "កុម្ភៈ", "មីនា", "មេសា", "ឧសភា", "មិថុនា", "កក្កដា", "សីហា", "កញ្ញា", "តុលា", "វិច្ឆិកា", "ធ្នូ"},
+		Month:     [12]string{"មករា", "កុម្ភៈ", "មីនា", "មេសា", "ឧសភា", "មិថុនា", "កក្កដា", "សីហា", "កញ្ញា", "តុលា", "វិច្ឆិកា", "ធ្នូ"},
+	},
+	{
+		Tag:        language.MustParse("kn"),
+		DTfmt:      "%b %-d, %Y %I:%M:%S %p",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%I:%M:%S %p",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%I:%M %p",
+		AmPm:       [2]string{"ಪೂರ್ವಾಹ್ನ", "ಅಪರಾಹ್ನ"},
+		AbDay:      [7]string{"ಭಾನು", "ಸೋಮ", "ಮಂಗಳ", "ಬುಧ", "ಗುರು", "ಶುಕ್ರ", "ಶನಿ"},
+		Day:        [7]string{"ಭಾನುವಾರ", "ಸೋಮವಾರ", "ಮಂಗಳವಾರ", "ಬುಧವಾರ", "ಗುರುವಾರ", "ಶುಕ್ರವಾರ", "ಶನಿವಾರ"},
+		AbMonth:    [12]string{"ಜನವರಿ", "ಫೆಬ್ರವರಿ", "ಮಾರ್ಚ್", "ಏಪ್ರಿ", "ಮೇ", "ಜೂನ್", "ಜುಲೈ", "ಆಗ", "ಸೆಪ್ಟೆಂ", "ಅಕ್ಟೋ", "ನವೆಂ", "ಡಿಸೆಂ"},
+		Month:      [12]string{"ಜನವರಿ", "ಫೆಬ್ರವರಿ", "ಮಾರ್ಚ್", "ಏಪ್ರಿಲ್", "ಮೇ", "ಜೂನ್", "ಜುಲೈ", "ಆಗಸ್ಟ್", "ಸೆಪ್ಟೆಂಬರ್", "ಅಕ್ಟೋಬರ್", "ನವೆಂಬರ್", "ಡಿಸೆಂಬರ್"},
+		AbAltMonth: [12]string{"ಜನ", "ಫೆಬ್ರ", "ಮಾರ್ಚ್", "ಏಪ್ರಿ", "ಮೇ", "ಜೂನ್", "ಜುಲೈ", "ಆಗ", "ಸೆಪ್ಟೆಂ", "ಅಕ್ಟೋ", "ನವೆಂ", "ಡಿಸೆಂ"},
+		AltMonth:   [12]string{"ಜನವರಿ", "ಫೆಬ್ರವರಿ", "ಮಾರ್ಚ್", "ಏಪ್ರಿಲ್", "ಮೇ", "ಜೂನ್", "ಜುಲೈ", "ಆಗಸ್ಟ್", "ಸೆಪ್ಟೆಂಬರ್", "ಅಕ್ಟೋಬರ್", "ನವೆಂಬರ್", "ಡಿಸೆಂಬರ್"},
+	},
+	{
+		Tag:       language.MustParse("ko"),
+		DTfmt:     "%Y. %-m. %-d. %p %-I:%M:%S",
+		Dfmt:      "%Y. %-m. %-d.",
+		Tfmt:      "%p %-I:%M:%S",
+		Tfmt12:    "%p %-I:%M:%S",
+		DfmtShort: "%y. %-m. %-d.",
+		TfmtShort: "%p %-I:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		Day:       [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		AbMonth:   [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		Month:     [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+	},
+	{
+		Tag:       language.MustParse("kok"),
+		DTfmt:     "%d-%m-%Y %-I:%M:%S %p",
+		Dfmt:      "%-d-%-m-%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d-%-m-%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"म.पू.", "म.नं."},
+		AbDay:     [7]string{"आयतार", "सोमार", "मंगळार", "बुधवार", "गुरुवार", "शुक्रार", "शेनवार"},
+		Day:       [7]string{"आयतार", "सोमार", "मंगळार", "बुधवार", "गुरुवार", "शुक्रार", "शेनवार"},
+		AbMonth:   [12]string{"जानेवारी", "फेब्रुवारी", "मार्च", "एप्रिल", "मे", "जून", "जुलाय", "आगोस्त", "सप्टेंबर", "ऑक्टोबर", "नोव्हेंबर", "डिसेंबर"},
+		Month:     [12]string{"जानेवारी", "फेब्रुवारी", "मार्च", "एप्रिल", "मे", "जून", "जुलाय", "आगोस्त", "सप्टेंबर", "ऑक्टोबर", "नोव्हेंबर", "डिसेंबर"},
+	},
+	{
+		Tag:       language.MustParse("ks"),
+		DTfmt:     "%b %-d, %Y %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"آتھوار", "ژٔنٛدٕروار", "بوٚموار", "بودوار", "برٛٮ۪سوار", "جُمہ", "بٹوار"},
+		Day:       [7]string{"اَتھوار", "ژٔنٛدرٕروار", "بوٚموار", "بودوار", "برٛٮ۪سوار", "جُمہ", "بٹوار"},
+		AbMonth:   [12]string{"جنؤری", "فرؤری", "مارٕچ", "اپریل", "میٔ", "جوٗن", "جوٗلایی", "اگست", "ستمبر", "اکتوٗبر", "نومبر", "دسمبر"},
+		Month:     [12]string{"جنؤری", "فرؤری", "مارٕچ", "اپریل", "میٔ", "جوٗن", "جوٗلایی", "اگست", "ستمبر", "اکتوٗبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:       language.MustParse("ksb"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"makeo", "nyiaghuo"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jmn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Jumaapii", "Jumaatatu", "Jumaane", "Jumaatano", "Alhamisi", "Ijumaa", "Jumaamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januali", "Febluali", "Machi", "Aplili", "Mei", "Juni", "Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("ksf"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"sárúwá", "cɛɛ́nko"},
+		AbDay:     [7]string{"sɔ́n", "lǝn", "maa", "mɛk", "jǝǝ", "júm", "sam"},
+		Day:       [7]string{"sɔ́ndǝ", "lǝndí", "maadí", "mɛkrɛdí", "jǝǝdí", "júmbá", "samdí"},
+		AbMonth:   [12]string{"ŋ1", "ŋ2", "ŋ3", "ŋ4", "ŋ5", "ŋ6", "ŋ7", "ŋ8", "ŋ9", "ŋ10", "ŋ11", "ŋ12"},
+		Month:     [12]string{"ŋwíí a ntɔ́ntɔ", "ŋwíí akǝ bɛ́ɛ", "ŋwíí akǝ ráá", "ŋwíí akǝ nin", "ŋwíí akǝ táan", "ŋwíí akǝ táafɔk", "ŋwíí akǝ táabɛɛ", "ŋwíí akǝ táaraa", "ŋwíí akǝ táanin", "ŋwíí akǝ ntɛk", "ŋwíí akǝ ntɛk di bɔ́k", "ŋwíí akǝ ntɛk di bɛ́ɛ"},
+	},
+	{
+		Tag:        language.MustParse("ksh"),
+		DTfmt:      "%-d. %b. %Y %H:%M:%S",
+		Dfmt:       "%-d. %-m. %Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d. %-m. %y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"v.M.", "n.M."},
+		AbDay:      [7]string{"Su.", "Mo.", "Di.", "Me.", "Du.", "Fr.", "Sa."},
+		Day:        [7]string{"Sunndaach", "Mohndaach", "Dinnsdaach", "Metwoch", "Dunnersdaach", "Friidaach", "Samsdaach"},
+		AbMonth:    [12]string{"Jan", "Fäb", "Mäz", "Apr", "Mai", "Jun", "Jul", "Ouj", "Säp", "Okt", "Nov", "Dez"},
+		Month:      [12]string{"Jannewa", "Fäbrowa", "Määz", "Aprell", "Mai", "Juuni", "Juuli", "Oujoß", "Septämber", "Oktohber", "Novämber", "Dezämber"},
+		AbAltMonth: [12]string{"Jan.", "Fäb.", "Mäz.", "Apr.", "Mai", "Jun.", "Jul.", "Ouj.", "Säp.", "Okt.", "Nov.", "Dez."},
+		AltMonth:   [12]string{"Jannewa", "Fäbrowa", "Määz", "Aprell", "Mai", "Juuni", "Juuli", "Oujoß", "Septämber", "Oktohber", "Novämber", "Dezämber"},
+	},
+	{
+		Tag:       language.MustParse("kw"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"Sul", "Lun", "Mth", "Mhr", "Yow", "Gwe", "Sad"},
+		Day:       [7]string{"dy Sul", "dy Lun", "dy Meurth", "dy Merher", "dy Yow", "dy Gwener", "dy Sadorn"},
+		AbMonth:   [12]string{"Gen", "Hwe", "Meu", "Ebr", "Me", "Met", "Gor", "Est", "Gwn", "Hed", "Du", "Kev"},
+		Month:     [12]string{"mis Genver", "mis Hwevrer", "mis Meurth", "mis Ebrel", "mis Me", "mis Metheven", "mis Gortheren", "mis Est", "mis Gwynngala", "mis Hedra", "mis Du", "mis Kevardhu"},
+	},
+	{
+		Tag:        language.MustParse("ky"),
+		DTfmt:      "%Y-ж., %-d-%b %H:%M:%S",
+		Dfmt:       "%-d/%-m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d/%-m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"тң", "тк"},
+		AbDay:      [7]string{"жек.", "дүй.", "шейш.", "шарш.", "бейш.", "жума", "ишм."},
+		Day:        [7]string{"жекшемби", "дүйшөмбү", "шейшемби", "шаршемби", "бейшемби", "жума", "ишемби"},
+		AbMonth:    [12]string{"янв.", "фев.", "мар.", "апр.", "май", "июн.", "июл.", "авг.", "сен.", "окт.", "ноя.", "дек."},
+		Month:      [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+		AbAltMonth: [12]string{"Янв", "Фев", "Мар", "Апр", "Май", "Июн", "Июл", "Авг", "Сен", "Окт", "Ноя", "Дек"},
+		AltMonth:   [12]string{"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+	},
+	{
+		Tag:       language.MustParse("lag"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"TOO", "MUU"},
+		AbDay:     [7]string{"Píili", "Táatu", "Íne", "Táano", "Alh", "Ijm", "Móosi"},
+		Day:       [7]string{"Jumapíiri", "Jumatátu", "Jumaíne", "Jumatáano", "Alamíisi", "Ijumáa", "Jumamóosi"},
+		AbMonth:   [12]string{"Fúngatɨ", "Naanɨ", "Keenda", "Ikúmi", "Inyambala", "Idwaata", "Mʉʉnchɨ", "Vɨɨrɨ", "Saatʉ", "Inyi", "Saano", "Sasatʉ"},
+		Month:     [12]string{"Kʉfúngatɨ", "Kʉnaanɨ", "Kʉkeenda", "Kwiikumi", "Kwiinyambála", "Kwiidwaata", "Kʉmʉʉnchɨ", "Kʉvɨɨrɨ", "Kʉsaatʉ", "Kwiinyi", "Kʉsaano", "Kʉsasatʉ"},
+	},
+	{
+		Tag:        language.MustParse("lb"),
+		DTfmt:      "%-d. %b %Y %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"moies", "nomëttes"},
+		AbDay:      [7]string{"Son.", "Méi.", "Dën.", "Mët.", "Don.", "Fre.", "Sam."},
+		Day:        [7]string{"Sonndeg", "Méindeg", "Dënschdeg", "Mëttwoch", "Donneschdeg", "Freideg", "Samschdeg"},
+		AbMonth:    [12]string{"Jan.", "Feb.", "Mäe.", "Abr.", "Mee", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."},
+		Month:      [12]string{"Januar", "Februar", "Mäerz", "Abrëll", "Mee", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		AbAltMonth: [12]string{"Jan", "Feb", "Mäe", "Abr", "Mee", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		AltMonth:   [12]string{"Januar", "Februar", "Mäerz", "Abrëll", "Mee", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	},
+	{
+		Tag:       language.MustParse("lg"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sab", "Bal", "Lw2", "Lw3", "Lw4", "Lw5", "Lw6"},
+		Day:       [7]string{"Sabbiiti", "Balaza", "Lwakubiri", "Lwakusatu", "Lwakuna", "Lwakutaano", "Lwamukaaga"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apu", "Maa", "Juu", "Jul", "Agu", "Seb", "Oki", "Nov", "Des"},
+		Month:     [12]string{"Janwaliyo", "Febwaliyo", "Marisi", "Apuli", "Maayi", "Juuni", "Julaayi", "Agusito", "Sebuttemba", "Okitobba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("lkt"),
+		DTfmt:     "%b %-d, %Y %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Aŋpétuwakȟaŋ", "Aŋpétuwaŋži", "Aŋpétunuŋpa", "Aŋpétuyamni", "Aŋpétutopa", "Aŋpétuzaptaŋ", "Owáŋgyužažapi"},
+		Day:       [7]string{"Aŋpétuwakȟaŋ", "Aŋpétuwaŋži", "Aŋpétunuŋpa", "Aŋpétuyamni", "Aŋpétutopa", "Aŋpétuzaptaŋ", "Owáŋgyužažapi"},
+		AbMonth:   [12]string{"Wiótheȟika Wí", "Thiyóȟeyuŋka Wí", "Ištáwičhayazaŋ Wí", "Pȟežítȟo Wí", "Čhaŋwápetȟo Wí", "Wípazukȟa-wašté Wí", "Čhaŋpȟásapa Wí", "Wasútȟuŋ Wí", "Čhaŋwápeǧi Wí", "Čhaŋwápe-kasná Wí", "Waníyetu Wí", "Tȟahékapšuŋ Wí"},
+		Month:     [12]string{"Wiótheȟika Wí", "Thiyóȟeyuŋka Wí", "Ištáwičhayazaŋ Wí", "Pȟežítȟo Wí", "Čhaŋwápetȟo Wí", "Wípazukȟa-wašté Wí", "Čhaŋpȟásapa Wí", "Wasútȟuŋ Wí", "Čhaŋwápeǧi Wí", "Čhaŋwápe-kasná Wí", "Waníyetu Wí", "Tȟahékapšuŋ Wí"},
+	},
+	{
+		Tag:       language.MustParse("ln"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ntɔ́ngɔ́", "mpókwa"},
+		AbDay:     [7]string{"eye", "ybo", "mbl", "mst", "min", "mtn", "mps"},
+		Day:       [7]string{"eyenga", "mokɔlɔ mwa yambo", "mokɔlɔ mwa míbalé", "mokɔlɔ mwa mísáto", "mokɔlɔ ya mínéi", "mokɔlɔ ya mítáno", "mpɔ́sɔ"},
+		AbMonth:   [12]string{"yan", "fbl", "msi", "apl", "mai", "yun", "yul", "agt", "stb", "ɔtb", "nvb", "dsb"},
+		Month:     [12]string{"sánzá ya yambo", "sánzá ya míbalé", "sánzá ya mísáto", "sánzá ya mínei", "sánzá ya mítáno", "sánzá ya motóbá", "sánzá ya nsambo", "sánzá ya mwambe", "sánzá ya libwa", "sánzá ya zómi", "sánzá ya zómi na mɔ̌kɔ́", "sánzá ya zómi na míbalé"},
+	},
+	{
+		Tag:       language.MustParse("lo"),
+		DTfmt:     "%-d %b %Y, %-H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"ກ່ອນທ່ຽງ", "ຫຼັງທ່ຽງ"},
+		AbDay:     [7]string{"ອາທິດ", "ຈັນ", "ອັງຄານ", "ພຸດ", "ພະຫັດ", "ສຸກ", "ເສົາ"},
+		Day:       [7]string{"ວັນອາທິດ", "ວັນຈັນ", "ວັນອັງຄານ", "ວັນພຸດ", "ວັນພະຫັດ", "ວັນສຸກ", "ວັນເສົາ"},
+		AbMonth:   [12]string{"ມ.ກ.", "ກ.ພ.", "ມ.ນ.", "ມ.ສ.", "ພ.ພ.", "ມິ.ຖ.", "ກ.ລ.", "ສ.ຫ.", "ກ.ຍ.", "ຕ.ລ.", "ພ.ຈ.", "ທ.ວ."},
+		Month:     [12]string{"ມັງກອນ", "ກຸມພາ", "ມີນາ", "ເມສາ", "ພຶດສະພາ", "ມິຖຸນາ", "ກໍລະກົດ", "ສິງຫາ", "ກັນຍາ", "ຕຸລາ", "ພະຈິກ", "ທັນວາ"},
+	},
+	{
+		Tag:       language.MustParse("lrc-IQ"),
+		DTfmt:     "%Y %b %-d %-I:%M:%S %p",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		AbMonth:   [12]string{"جانڤیە", "فئڤریە", "مارس", "آڤریل", "مئی", "جوٙأن", "جوٙلا", "آگوست", "سئپتامر", "ئوکتوڤر", "نوڤامر", "دئسامر"},
+		Month:     [12]string{"جانڤیە", "فئڤریە", "مارس", "آڤریل", "مئی", "جوٙأن", "جوٙلا", "آگوست", "سئپتامر", "ئوکتوڤر", "نوڤامر", "دئسامر"},
+	},
+	{
+		Tag:       language.MustParse("lrc"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		AbMonth:   [12]string{"جانڤیە", "فئڤریە", "مارس", "آڤریل", "مئی", "جوٙأن", "جوٙلا", "آگوست", "سئپتامر", "ئوکتوڤر", "نوڤامر", "دئسامر"},
+		Month:     [12]string{"جانڤیە", "فئڤریە", "مارس", "آڤریل", "مئی", "جوٙأن", "جوٙلا", "آگوست", "سئپتامر", "ئوکتوڤر", "نوڤامر", "دئسامر"},
+	},
+	{
+		Tag:        language.MustParse("lt"),
+		DTfmt:      "%Y-%m-%d %H:%M:%S",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"priešpiet", "popiet"},
+		AbDay:      [7]string{"sk", "pr", "an", "tr", "kt", "pn", "št"},
+		Day:        [7]string{"sekmadienis", "pirmadienis", "antradienis", "trečiadienis", "ketvirtadienis", "penktadienis", "šeštadienis"},
+		AbMonth:    [12]string{"saus.", "vas.", "kov.", "bal.", "geg.", "birž.", "liep.", "rugp.", "rugs.", "spal.", "lapkr.", "gruod."},
+		Month:      [12]string{"sausio", "vasario", "kovo", "balandžio", "gegužės", "birželio", "liepos", "rugpjūčio", "rugsėjo", "spalio", "lapkričio", "gruodžio"},
+		AbAltMonth: [12]string{"saus.", "vas.", "kov.", "bal.", "geg.", "birž.", "liep.", "rugp.", "rugs.", "spal.", "lapkr.", "gruod."},
+		AltMonth:   [12]string{"sausis", "vasaris", "kovas", "balandis", "gegužė", "birželis", "liepa", "rugpjūtis", "rugsėjis", "spalis", "lapkritis", "gruodis"},
+	},
+	{
+		Tag:       language.MustParse("lu"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Dinda", "Dilolo"},
+		AbDay:     [7]string{"Lum", "Nko", "Ndy", "Ndg", "Njw", "Ngv", "Lub"},
+		Day:       [7]string{"Lumingu", "Nkodya", "Ndàayà", "Ndangù", "Njòwa", "Ngòvya", "Lubingu"},
+		AbMonth:   [12]string{"Cio", "Lui", "Lus", "Muu", "Lum", "Luf", "Kab", "Lush", "Lut", "Lun", "Kas", "Cis"},
+		Month:     [12]string{"Ciongo", "Lùishi", "Lusòlo", "Mùuyà", "Lumùngùlù", "Lufuimi", "Kabàlàshìpù", "Lùshìkà", "Lutongolo", "Lungùdi", "Kaswèkèsè", "Ciswà"},
+	},
+	{
+		Tag:       language.MustParse("luo"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"OD", "OT"},
+		AbDay:     [7]string{"JMP", "WUT", "TAR", "TAD", "TAN", "TAB", "NGS"},
+		Day:       [7]string{"Jumapil", "Wuok Tich", "Tich Ariyo", "Tich Adek", "Tich Ang’wen", "Tich Abich", "Ngeso"},
+		AbMonth:   [12]string{"DAC", "DAR", "DAD", "DAN", "DAH", "DAU", "DAO", "DAB", "DOC", "DAP", "DGI", "DAG"},
+		Month:     [12]string{"Dwe mar Achiel", "Dwe mar Ariyo", "Dwe mar Adek", "Dwe mar Ang’wen", "Dwe mar Abich", "Dwe mar Auchiel", "Dwe mar Abiriyo", "Dwe mar Aboro", "Dwe mar Ochiko", "Dwe mar Apar", "Dwe mar gi achiel", "Dwe mar Apar gi ariyo"},
+	},
+	{
+		Tag:       language.MustParse("luy"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"J2", "J3", "J4", "J5", "Al", "Ij", "J1"},
+		Day:       [7]string{"Jumapiri", "Jumatatu", "Jumanne", "Jumatano", "Murwa wa Kanne", "Murwa wa Katano", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprili", "Mei", "Juni", "Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("lv"),
+		DTfmt:     "%Y. gada %-d. %b %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"priekšp.", "pēcp."},
+		AbDay:     [7]string{"svētd.", "pirmd.", "otrd.", "trešd.", "ceturtd.", "piektd.", "sestd."},
+		Day:       [7]string{"svētdiena", "pirmdiena", "otrdiena", "trešdiena", "ceturtdiena", "piektdiena", "sestdiena"},
+		AbMonth:   [12]string{"janv.", "febr.", "marts", "apr.", "maijs", "jūn.", "jūl.", "aug.", "sept.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"janvāris", "februāris", "marts", "aprīlis", "maijs", "jūnijs", "jūlijs", "augusts", "septembris", "oktobris", "novembris", "decembris"},
+	},
+	{
+		Tag:       language.MustParse("mas"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Ɛnkakɛnyá", "Ɛndámâ"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jnn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Jumapílí", "Jumatátu", "Jumane", "Jumatánɔ", "Alaámisi", "Jumáa", "Jumamósi"},
+		AbMonth:   [12]string{"Dal", "Ará", "Ɔɛn", "Doy", "Lép", "Rok", "Sás", "Bɔ́r", "Kús", "Gís", "Shʉ́", "Ntʉ́"},
+		Month:     [12]string{"Oladalʉ́", "Arát", "Ɔɛnɨ́ɔɨŋɔk", "Olodoyíóríê inkókúâ", "Oloilépūnyīē inkókúâ", "Kújúɔrɔk", "Mórusásin", "Ɔlɔ́ɨ́bɔ́rárɛ", "Kúshîn", "Olgísan", "Pʉshʉ́ka", "Ntʉ́ŋʉ́s"},
+	},
+	{
+		Tag:       language.MustParse("mer"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"RŨ", "ŨG"},
+		AbDay:     [7]string{"KIU", "MRA", "WAI", "WET", "WEN", "WTN", "JUM"},
+		Day:       [7]string{"Kiumia", "Muramuko", "Wairi", "Wethatu", "Wena", "Wetano", "Jumamosi"},
+		AbMonth:   [12]string{"JAN", "FEB", "MAC", "ĨPU", "MĨĨ", "NJU", "NJR", "AGA", "SPT", "OKT", "NOV", "DEC"},
+		Month:     [12]string{"Januarĩ", "Feburuarĩ", "Machi", "Ĩpurũ", "Mĩĩ", "Njuni", "Njuraĩ", "Agasti", "Septemba", "Oktũba", "Novemba", "Dicemba"},
+	},
+	{
+		Tag:       language.MustParse("mfe"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dim", "lin", "mar", "mer", "ze", "van", "sam"},
+		Day:       [7]string{"dimans", "lindi", "mardi", "merkredi", "zedi", "vandredi", "samdi"},
+		AbMonth:   [12]string{"zan", "fev", "mar", "avr", "me", "zin", "zil", "out", "sep", "okt", "nov", "des"},
+		Month:     [12]string{"zanvie", "fevriye", "mars", "avril", "me", "zin", "zilye", "out", "septam", "oktob", "novam", "desam"},
+	},
+	{
+		Tag:       language.MustParse("mg"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Alah", "Alats", "Tal", "Alar", "Alak", "Zom", "Asab"},
+		Day:       [7]string{"Alahady", "Alatsinainy", "Talata", "Alarobia", "Alakamisy", "Zoma", "Asabotsy"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "Mey", "Jon", "Jol", "Aog", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Janoary", "Febroary", "Martsa", "Aprily", "Mey", "Jona", "Jolay", "Aogositra", "Septambra", "Oktobra", "Novambra", "Desambra"},
+	},
+	{
+		Tag:       language.MustParse("mgh"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"wichishu", "mchochil’l"},
+		AbDay:     [7]string{"Sab", "Jtt", "Jnn", "Jtn", "Ara", "Iju", "Jmo"},
+		Day:       [7]string{"Sabato", "Jumatatu", "Jumanne", "Jumatano", "Arahamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Kwa", "Una", "Rar", "Che", "Tha", "Moc", "Sab", "Nan", "Tis", "Kum", "Moj", "Yel"},
+		Month:     [12]string{"Mweri wo kwanza", "Mweri wo unayeli", "Mweri wo uneraru", "Mweri wo unecheshe", "Mweri wo unethanu", "Mweri wo thanu na mocha", "Mweri wo saba", "Mweri wo nane", "Mweri wo tisa", "Mweri wo kumi", "Mweri wo kumi na moja", "Mweri wo kumi na yel’li"},
+	},
+	{
+		Tag:       language.MustParse("mgo"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Aneg 1", "Aneg 2", "Aneg 3", "Aneg 4", "Aneg 5", "Aneg 6", "Aneg 7"},
+		Day:       [7]string{"Aneg 1", "Aneg 2", "Aneg 3", "Aneg 4", "Aneg 5", "Aneg 6", "Aneg 7"},
+		AbMonth:   [12]string{"mbegtug", "imeg àbùbì", "imeg mbəŋchubi", "iməg ngwə̀t", "iməg fog", "iməg ichiibɔd", "iməg àdùmbə̀ŋ", "iməg ichika", "iməg kud", "iməg tèsiʼe", "iməg zò", "iməg krizmed"},
+		Month:     [12]string{"iməg mbegtug", "imeg àbùbì", "imeg mbəŋchubi", "iməg ngwə̀t", "iməg fog", "iməg ichiibɔd", "iməg àdùmbə̀ŋ", "iməg ichika", "iməg kud", "iməg tèsiʼe", "iməg zò", "iməg krizmed"},
+	},
+	{
+		Tag:       language.MustParse("mk"),
+		DTfmt:     "%d.%-m.%Y %H:%M:%S",
+		Dfmt:      "%d.%-m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%-m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"претпл.", "попл."},
+		AbDay:     [7]string{"нед.", "пон.", "вт.", "сре.", "чет.", "пет.", "саб."},
+		Day:       [7]string{"недела", "понеделник", "вторник", "среда", "четврток", "петок", "сабота"},
+		AbMonth:   [12]string{"јан.", "фев.", "мар.", "апр.", "мај", "јун.", "јул.", "авг.", "септ.", "окт.", "ноем.", "дек."},
+		Month:     [12]string{"јануари", "февруари", "март", "април", "мај", "јуни", "јули", "август", "септември", "октомври", "ноември", "декември"},
+	},
+	{
+		Tag:       language.MustParse("ml"),
+		DTfmt:     "%Y, %b %-d %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"ഞായർ", "തിങ്കൾ", "ചൊവ്വ", "ബുധൻ", "വ്യാഴം", "വെള്ളി", "ശനി"},
+		Day:       [7]string{"ഞായറാഴ്\u200cച", "തിങ്കളാഴ്\u200cച", "ചൊവ്വാഴ്ച", "ബുധനാഴ്\u200cച", "വ്യാഴാഴ്\u200cച", "വെള്ളിയാഴ്\u200cച", "ശനിയാഴ്\u200cച"},
+		AbMonth:   [12]string{"ജനു", "ഫെബ്രു", "മാർ", "ഏപ്രി", "മേയ്", "ജൂൺ", "ജൂലൈ", "ഓഗ", "സെപ്റ്റം", "ഒക്ടോ", "നവം", "ഡിസം"},
+		Month:     [12]string{"ജനുവരി", "ഫെബ്രുവരി", "മാർച്ച്", "ഏപ്രിൽ", "മേയ്", "ജൂൺ", "ജൂലൈ", "ഓഗസ്റ്റ്", "സെപ്റ്റംബർ", "ഒക്\u200cടോബർ", "നവംബർ", "ഡിസംബർ"},
+	},
+	{
+		Tag:       language.MustParse("mn"),
+		DTfmt:     "%Y.%m.%d %H:%M:%S",
+		Dfmt:      "%Y.%m.%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y.%m.%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ҮӨ", "ҮХ"},
+		AbDay:     [7]string{"Ня", "Да", "Мя", "Лх", "Пү", "Ба", "Бя"},
+		Day:       [7]string{"ням", "даваа", "мягмар", "лхагва", "пүрэв", "баасан", "бямба"},
+		AbMonth:   [12]string{"1-р сар", "2-р сар", "3-р сар", "4-р сар", "5-р сар", "6-р сар", "7-р сар", "8-р сар", "9-р сар", "10-р сар", "11-р сар", "12-р сар"},
+		Month:     [12]string{"Нэгдүгээр сар", "Хоёрдугаар сар", "Гуравдугаар сар", "Дөрөвдүгээр сар", "Тавдугаар сар", "Зургаадугаар сар", "Долдугаар сар", "Наймдугаар сар", "Есдүгээр сар", "Аравдугаар сар", "Арван нэгдүгээр сар", "Арван хоёрдугаар сар"},
+	},
+	{
+		Tag:       language.MustParse("mr"),
+		DTfmt:     "%-d %b, %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"म.पू.", "म.उ."},
+		AbDay:     [7]string{"रवि", "सोम", "मंगळ", "बुध", "गुरु", "शुक्र", "शनि"},
+		Day:       [7]string{"रविवार", "सोमवार", "मंगळवार", "बुधवार", "गुरुवार", "शुक्रवार", "शनिवार"},
+		AbMonth:   [12]string{"जाने", "फेब्रु", "मार्च", "एप्रि", "मे", "जून", "जुलै", "ऑग", "सप्टें", "ऑक्टो", "नोव्हें", "डिसें"},
+		Month:     [12]string{"जानेवारी", "फेब्रुवारी", "मार्च", "एप्रिल", "मे", "जून", "जुलै", "ऑगस्ट", "सप्टेंबर", "ऑक्टोबर", "नोव्हेंबर", "डिसेंबर"},
+	},
+	{
+		Tag:       language.MustParse("ms"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"PG", "PTG"},
+		AbDay:     [7]string{"Ahd", "Isn", "Sel", "Rab", "Kha", "Jum", "Sab"},
+		Day:       [7]string{"Ahad", "Isnin", "Selasa", "Rabu", "Khamis", "Jumaat", "Sabtu"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ogo", "Sep", "Okt", "Nov", "Dis"},
+		Month:     [12]string{"Januari", "Februari", "Mac", "April", "Mei", "Jun", "Julai", "Ogos", "September", "Oktober", "November", "Disember"},
+	},
+	{
+		Tag:       language.MustParse("mt"),
+		DTfmt:     "%d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Ħad", "Tne", "Tli", "Erb", "Ħam", "Ġim", "Sib"},
+		Day:       [7]string{"Il-Ħadd", "It-Tnejn", "It-Tlieta", "L-Erbgħa", "Il-Ħamis", "Il-Ġimgħa", "Is-Sibt"},
+		AbMonth:   [12]string{"Jan", "Fra", "Mar", "Apr", "Mej", "Ġun", "Lul", "Aww", "Set", "Ott", "Nov", "Diċ"},
+		Month:     [12]string{"Jannar", "Frar", "Marzu", "April", "Mejju", "Ġunju", "Lulju", "Awwissu", "Settembru", "Ottubru", "Novembru", "Diċembru"},
+	},
+	{
+		Tag:       language.MustParse("mua"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"comme", "lilli"},
+		AbDay:     [7]string{"Cya", "Cla", "Czi", "Cko", "Cka", "Cga", "Cze"},
+		Day:       [7]string{"Com’yakke", "Comlaaɗii", "Comzyiiɗii", "Comkolle", "Comkaldǝɓlii", "Comgaisuu", "Comzyeɓsuu"},
+		AbMonth:   [12]string{"FLO", "CLA", "CKI", "FMF", "MAD", "MBI", "MLI", "MAM", "FDE", "FMU", "FGW", "FYU"},
+		Month:     [12]string{"Fĩi Loo", "Cokcwaklaŋne", "Cokcwaklii", "Fĩi Marfoo", "Madǝǝuutǝbijaŋ", "Mamǝŋgwãafahbii", "Mamǝŋgwãalii", "Madǝmbii", "Fĩi Dǝɓlii", "Fĩi Mundaŋ", "Fĩi Gwahlle", "Fĩi Yuru"},
+	},
+	{
+		Tag:       language.MustParse("mzn"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		Day:       [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		AbMonth:   [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+		Month:     [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+	},
+	{
+		Tag:       language.MustParse("naq"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ǁgoagas", "ǃuias"},
+		AbDay:     [7]string{"Son", "Ma", "De", "Wu", "Do", "Fr", "Sat"},
+		Day:       [7]string{"Sontaxtsees", "Mantaxtsees", "Denstaxtsees", "Wunstaxtsees", "Dondertaxtsees", "Fraitaxtsees", "Satertaxtsees"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Month:     [12]string{"ǃKhanni", "ǃKhanǀgôab", "ǀKhuuǁkhâb", "ǃHôaǂkhaib", "ǃKhaitsâb", "Gamaǀaeb", "ǂKhoesaob", "Aoǁkhuumûǁkhâb", "Taraǀkhuumûǁkhâb", "ǂNûǁnâiseb", "ǀHooǂgaeb", "Hôasoreǁkhâb"},
+	},
+	{
+		Tag:        language.MustParse("nb"),
+		DTfmt:      "%-d. %b %Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"a.m.", "p.m."},
+		AbDay:      [7]string{"søn.", "man.", "tir.", "ons.", "tor.", "fre.", "lør."},
+		Day:        [7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+		AbMonth:    [12]string{"jan.", "feb.", "mar.", "apr.", "mai", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "des."},
+		Month:      [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+		AbAltMonth: [12]string{"jan", "feb", "mar", "apr", "mai", "jun", "jul", "aug", "sep", "okt", "nov", "des"},
+		AltMonth:   [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+	},
+	{
+		Tag:       language.MustParse("nd"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Son", "Mvu", "Sib", "Sit", "Sin", "Sih", "Mgq"},
+		Day:       [7]string{"Sonto", "Mvulo", "Sibili", "Sithathu", "Sine", "Sihlanu", "Mgqibelo"},
+		AbMonth:   [12]string{"Zib", "Nhlo", "Mbi", "Mab", "Nkw", "Nhla", "Ntu", "Ncw", "Mpan", "Mfu", "Lwe", "Mpal"},
+		Month:     [12]string{"Zibandlela", "Nhlolanja", "Mbimbitho", "Mabasa", "Nkwenkwezi", "Nhlangula", "Ntulikazi", "Ncwabakazi", "Mpandula", "Mfumfu", "Lwezi", "Mpalakazi"},
+	},
+	{
+		Tag:       language.MustParse("nds"),
+		DTfmt:     "%-d. %b %Y, Klock %-H.%M:%S",
+		Dfmt:      "%-d.%m.%Y",
+		Tfmt:      "Klock %-H.%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%m.%y",
+		TfmtShort: "Kl. %-H.%M",
+		AmPm:      [2]string{"vm", "nm"},
+		AbDay:     [7]string{"Sü.", "Ma.", "Di.", "Mi.", "Du.", "Fr.", "Sa."},
+		Day:       [7]string{"Sünndag", "Maandag", "Dingsdag", "Middeweken", "Dunnersdag", "Freedag", "Sünnavend"},
+		AbMonth:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."},
+		Month:     [12]string{"Januaar", "Februaar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktover", "November", "Dezember"},
+	},
+	{
+		Tag:       language.MustParse("ne-IN"),
+		DTfmt:     "%Y %b %-d, %-I:%M:%S %p",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"पूर्वाह्न", "अपराह्न"},
+		AbDay:     [7]string{"आइत", "सोम", "मङ्गल", "बुध", "बिहि", "शुक्र", "शनि"},
+		Day:       [7]string{"आइतबार", "सोमबार", "मङ्गलबार", "बुधबार", "बिहिबार", "शुक्रबार", "शनिबार"},
+		AbMonth:   [12]string{"जनवरी", "फेब्रुअरी", "मार्च", "अप्रिल", "मे", "जुन", "जुलाई", "अगस्ट", "सेप्टेम्बर", "अक्टोबर", "नोभेम्बर", "डिसेम्बर"},
+		Month:     [12]string{"जनवरी", "फेब्रुअरी", "मार्च", "अप्रिल", "मे", "जुन", "जुलाई", "अगस्ट", "सेप्टेम्बर", "अक्टोबर", "नोभेम्बर", "डिसेम्बर"},
+	},
+	{
+		Tag:       language.MustParse("ne"),
+		DTfmt:     "%Y %b %-d, %H:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"पूर्वाह्न", "अपराह्न"},
+		AbDay:     [7]string{"आइत", "सोम", "मङ्गल", "बुध", "बिहि", "शुक्र", "शनि"},
+		Day:       [7]string{"आइतबार", "सोमबार", "मङ्गलबार", "बुधबार", "बिहिबार", "शुक्रबार", "शनिबार"},
+		AbMonth:   [12]string{"जनवरी", "फेब्रुअरी", "मार्च", "अप्रिल", "मे", "जुन", "जुलाई", "अगस्ट", "सेप्टेम्बर", "अक्टोबर", "नोभेम्बर", "डिसेम्बर"},
+		Month:     [12]string{"जनवरी", "फेब्रुअरी", "मार्च", "अप्रिल", "मे", "जुन", "जुलाई", "अगस्ट", "सेप्टेम्बर", "अक्टोबर", "नोभेम्बर", "डिसेम्बर"},
+	},
+	{
+		Tag:       language.MustParse("nl-BE"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		Day:       [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		AbMonth:   [12]string{"jan.", "feb.", "mrt.", "apr.", "mei", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("nl"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		Day:       [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		AbMonth:   [12]string{"jan.", "feb.", "mrt.", "apr.", "mei", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("nmg"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"maná", "kugú"},
+		AbDay:     [7]string{"sɔ́n", "mɔ́n", "smb", "sml", "smn", "mbs", "sas"},
+		Day:       [7]string{"sɔ́ndɔ", "mɔ́ndɔ", "sɔ́ndɔ mafú mába", "sɔ́ndɔ mafú málal", "sɔ́ndɔ mafú mána", "mabágá má sukul", "sásadi"},
+		AbMonth:   [12]string{"ng1", "ng2", "ng3", "ng4", "ng5", "ng6", "ng7", "ng8", "ng9", "ng10", "ng11", "kris"},
+		Month:     [12]string{"ngwɛn matáhra", "ngwɛn ńmba", "ngwɛn ńlal", "ngwɛn ńna", "ngwɛn ńtan", "ngwɛn ńtuó", "ngwɛn hɛmbuɛrí", "ngwɛn lɔmbi", "ngwɛn rɛbvuâ", "ngwɛn wum", "ngwɛn wum navǔr", "krísimin"},
+	},
+	{
+		Tag:        language.MustParse("nn"),
+		DTfmt:      "%-d. %b %Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"f.m.", "e.m."},
+		AbDay:      [7]string{"sø.", "må.", "ty.", "on.", "to.", "fr.", "la."},
+		Day:        [7]string{"søndag", "måndag", "tysdag", "onsdag", "torsdag", "fredag", "laurdag"},
+		AbMonth:    [12]string{"jan.", "feb.", "mars", "apr.", "mai", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "des."},
+		Month:      [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+		AbAltMonth: [12]string{"jan", "feb", "mar", "apr", "mai", "jun", "jul", "aug", "sep", "okt", "nov", "des"},
+		AltMonth:   [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+	},
+	{
+		Tag:       language.MustParse("nnh"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"mbaʼámbaʼ", "ncwònzém"},
+		AbDay:     [7]string{"lyɛʼɛ́ sẅíŋtè", "mvfò lyɛ̌ʼ", "mbɔ́ɔntè mvfò lyɛ̌ʼ", "tsètsɛ̀ɛ lyɛ̌ʼ", "mbɔ́ɔntè tsetsɛ̀ɛ lyɛ̌ʼ", "mvfò màga lyɛ̌ʼ", "màga lyɛ̌ʼ"},
+		Day:       [7]string{"lyɛʼɛ́ sẅíŋtè", "mvfò lyɛ̌ʼ", "mbɔ́ɔntè mvfò lyɛ̌ʼ", "tsètsɛ̀ɛ lyɛ̌ʼ", "mbɔ́ɔntè tsetsɛ̀ɛ lyɛ̌ʼ", "mvfò màga lyɛ̌ʼ", "màga lyɛ̌ʼ"},
+		AbMonth:   [12]string{"saŋ tsetsɛ̀ɛ lùm", "saŋ kàg ngwóŋ", "saŋ lepyè shúm", "saŋ cÿó", "saŋ tsɛ̀ɛ cÿó", "saŋ njÿoláʼ", "saŋ tyɛ̀b tyɛ̀b mbʉ̀ŋ", "saŋ mbʉ̀ŋ", "saŋ ngwɔ̀ʼ mbÿɛ", "saŋ tàŋa tsetsáʼ", "saŋ mejwoŋó", "saŋ lùm"},
+		Month:     [12]string{"saŋ tsetsɛ̀ɛ lùm", "saŋ kàg ngwóŋ", "saŋ lepyè shúm", "saŋ cÿó", "saŋ tsɛ̀ɛ cÿó", "saŋ njÿoláʼ", "saŋ tyɛ̀b tyɛ̀b mbʉ̀ŋ", "saŋ mbʉ̀ŋ", "saŋ ngwɔ̀ʼ mbÿɛ", "saŋ tàŋa tsetsáʼ", "saŋ mejwoŋó", "saŋ lùm"},
+	},
+	{
+		Tag:       language.MustParse("nus"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"RW", "TŊ"},
+		AbDay:     [7]string{"Cäŋ", "Jiec", "Rɛw", "Diɔ̱k", "Ŋuaan", "Dhieec", "Bäkɛl"},
+		Day:       [7]string{"Cäŋ kuɔth", "Jiec la̱t", "Rɛw lätni", "Diɔ̱k lätni", "Ŋuaan lätni", "Dhieec lätni", "Bäkɛl lätni"},
+		AbMonth:   [12]string{"Tiop", "Pɛt", "Duɔ̱ɔ̱", "Guak", "Duä", "Kor", "Pay", "Thoo", "Tɛɛ", "Laa", "Kur", "Tid"},
+		Month:     [12]string{"Tiop thar pɛt", "Pɛt", "Duɔ̱ɔ̱ŋ", "Guak", "Duät", "Kornyoot", "Pay yie̱tni", "Tho̱o̱r", "Tɛɛr", "Laath", "Kur", "Tio̱p in di̱i̱t"},
+	},
+	{
+		Tag:       language.MustParse("nyn"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"SAN", "ORK", "OKB", "OKS", "OKN", "OKT", "OMK"},
+		Day:       [7]string{"Sande", "Orwokubanza", "Orwakabiri", "Orwakashatu", "Orwakana", "Orwakataano", "Orwamukaaga"},
+		AbMonth:   [12]string{"KBZ", "KBR", "KST", "KKN", "KTN", "KMK", "KMS", "KMN", "KMW", "KKM", "KNK", "KNB"},
+		Month:     [12]string{"Okwokubanza", "Okwakabiri", "Okwakashatu", "Okwakana", "Okwakataana", "Okwamukaaga", "Okwamushanju", "Okwamunaana", "Okwamwenda", "Okwaikumi", "Okwaikumi na kumwe", "Okwaikumi na ibiri"},
+	},
+	{
+		Tag:       language.MustParse("om-KE"),
+		DTfmt:     "%d-%b-%Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"WD", "WB"},
+		AbDay:     [7]string{"Dil", "Wix", "Qib", "Rob", "Kam", "Jim", "San"},
+		Day:       [7]string{"Dilbata", "Wiixata", "Qibxata", "Roobii", "Kamiisa", "Jimaata", "Sanbata"},
+		AbMonth:   [12]string{"Ama", "Gur", "Bit", "Elb", "Cam", "Wax", "Ado", "Hag", "Ful", "Onk", "Sad", "Mud"},
+		Month:     [12]string{"Amajjii", "Guraandhala", "Bitooteessa", "Elba", "Caamsa", "Waxabajjii", "Adooleessa", "Hagayya", "Fuulbana", "Onkololeessa", "Sadaasa", "Muddee"},
+	},
+	{
+		Tag:       language.MustParse("om"),
+		DTfmt:     "%d-%b-%Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"WD", "WB"},
+		AbDay:     [7]string{"Dil", "Wix", "Qib", "Rob", "Kam", "Jim", "San"},
+		Day:       [7]string{"Dilbata", "Wiixata", "Qibxata", "Roobii", "Kamiisa", "Jimaata", "Sanbata"},
+		AbMonth:   [12]string{"Ama", "Gur", "Bit", "Elb", "Cam", "Wax", "Ado", "Hag", "Ful", "Onk", "Sad", "Mud"},
+		Month:     [12]string{"Amajjii", "Guraandhala", "Bitooteessa", "Elba", "Caamsa", "Waxabajjii", "Adooleessa", "Hagayya", "Fuulbana", "Onkololeessa", "Sadaasa", "Muddee"},
+	},
+	{
+		Tag:       language.MustParse("or"),
+		DTfmt:     "%b %-d, %Y, %-I:%M:%S %p",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"am", "pm"},
+		AbDay:     [7]string{"ରବି", "ସୋମ", "ମଙ୍ଗଳ", "ବୁଧ", "ଗୁରୁ", "ଶୁକ୍ର", "ଶନି"},
+		Day:       [7]string{"ରବିବାର", "ସୋମବାର", "ମଙ୍ଗଳବାର", "ବୁଧବାର", "ଗୁରୁବାର", "ଶୁକ୍ରବାର", "ଶନିବାର"},
+		AbMonth:   [12]string{"ଜାନୁଆରୀ", "ଫେବୃଆରୀ", "ମାର୍ଚ୍ଚ", "ଅପ୍ରେଲ", "ମଇ", "ଜୁନ", "ଜୁଲାଇ", "ଅଗଷ୍ଟ", "ସେପ୍ଟେମ୍ବର", "ଅକ୍ଟୋବର", "ନଭେମ୍ବର", "ଡିସେମ୍ବର"},
+		Month:     [12]string{"ଜାନୁଆରୀ", "ଫେବୃଆରୀ", "ମାର୍ଚ୍ଚ", "ଅପ୍ରେଲ", "ମଇ", "ଜୁନ", "ଜୁଲାଇ", "ଅଗଷ୍ଟ", "ସେପ୍ଟେମ୍ବର", "ଅକ୍ଟୋବର", "ନଭେମ୍ବର", "ଡିସେମ୍ବର"},
+	},
+	{
+		Tag:        language.MustParse("os"),
+		DTfmt:      "%d %b %Y аз, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"хцб", "крс", "дцг", "ӕрт", "цпр", "мрб", "сбт"},
+		Day:        [7]string{"хуыцаубон", "къуырисӕр", "дыццӕг", "ӕртыццӕг", "цыппӕрӕм", "майрӕмбон", "сабат"},
+		AbMonth:    [12]string{"янв.", "фев.", "мар.", "апр.", "майы", "июны", "июлы", "авг.", "сен.", "окт.", "ноя.", "дек."},
+		Month:      [12]string{"январы", "февралы", "мартъийы", "апрелы", "майы", "июны", "июлы", "августы", "сентябры", "октябры", "ноябры", "декабры"},
+		AbAltMonth: [12]string{"Янв.", "Февр.", "Март.", "Апр.", "Май", "Июнь", "Июль", "Авг.", "Сент.", "Окт.", "Нояб.", "Дек."},
+		AltMonth:   [12]string{"Январь", "Февраль", "Мартъи", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+	},
+	{
+		Tag:       language.MustParse("pa-Arab"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"اتوار", "پیر", "منگل", "بُدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		Day:       [7]string{"اتوار", "پیر", "منگل", "بُدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		AbMonth:   [12]string{"جنوری", "فروری", "مارچ", "اپریل", "مئ", "جون", "جولائی", "اگست", "ستمبر", "اکتوبر", "نومبر", "دسمبر"},
+		Month:     [12]string{"جنوری", "فروری", "مارچ", "اپریل", "مئ", "جون", "جولائی", "اگست", "ستمبر", "اکتوبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:       language.MustParse("pa"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ਪੂ.ਦੁ.", "ਬਾ.ਦੁ."},
+		AbDay:     [7]string{"ਐਤ", "ਸੋਮ", "ਮੰਗਲ", "ਬੁੱਧ", "ਵੀਰ", "ਸ਼ੁੱਕਰ", "ਸ਼ਨਿੱਚਰ"},
+		Day:       [7]string{"ਐਤਵਾਰ", "ਸੋਮਵਾਰ", "ਮੰਗਲਵਾਰ", "ਬੁੱਧਵਾਰ", "ਵੀਰਵਾਰ", "ਸ਼ੁੱਕਰਵਾਰ", "ਸ਼ਨਿੱਚਰਵਾਰ"},
+		AbMonth:   [12]string{"ਜਨ", "ਫ਼ਰ", "ਮਾਰਚ", "ਅਪ੍ਰੈ", "ਮਈ", "ਜੂਨ", "ਜੁਲਾ", "ਅਗ", "ਸਤੰ", "ਅਕਤੂ", "ਨਵੰ", "ਦਸੰ"},
+		Month:     [12]string{"ਜਨਵਰੀ", "ਫ਼ਰਵਰੀ", "ਮਾਰਚ", "ਅਪ੍ਰੈਲ", "ਮਈ", "ਜੂਨ", "ਜੁਲਾਈ", "ਅਗਸਤ", "ਸਤੰਬਰ", "ਅਕਤੂਬਰ", "ਨਵੰਬਰ", "ਦਸੰਬਰ"},
+	},
+	{
+		Tag:        language.MustParse("pl"),
+		DTfmt:      "%-d %b %Y, %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		Day:        [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		AbMonth:    [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		Month:      [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		AbAltMonth: [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		AltMonth:   [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+	},
+	{
+		Tag:       language.MustParse("prg"),
+		DTfmt:     "%d.%m st. %Y %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"nad", "pan", "wis", "pus", "ket", "pēn", "sab"},
+		Day:       [7]string{"nadīli", "panadīli", "wisasīdis", "pussisawaiti", "ketwirtiks", "pēntniks", "sabattika"},
+		AbMonth:   [12]string{"rag", "was", "pūl", "sak", "zal", "sīm", "līp", "dag", "sil", "spa", "lap", "sal"},
+		Month:     [12]string{"rags", "wassarins", "pūlis", "sakkis", "zallaws", "sīmenis", "līpa", "daggis", "sillins", "spallins", "lapkrūtis", "sallaws"},
+	},
+	{
+		Tag:        language.MustParse("ps"),
+		DTfmt:      "%Y %b %-d %-H:%M:%S",
+		Dfmt:       "%Y/%-m/%-d",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y/%-m/%-d",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"غ.م.", "غ.و."},
+		AbDay:      [7]string{"يونۍ", "دونۍ", "درېنۍ", "څلرنۍ", "پينځنۍ", "جمعه", "اونۍ"},
+		Day:        [7]string{"يونۍ", "دونۍ", "درېنۍ", "څلرنۍ", "پينځنۍ", "جمعه", "اونۍ"},
+		AbMonth:    [12]string{"جنوري", "فبروري", "مارچ", "اپریل", "مۍ", "جون", "جولای", "اگست", "سېپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+		Month:      [12]string{"جنوري", "فبروري", "مارچ", "اپریل", "مۍ", "جون", "جولای", "اگست", "سېپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+		AbAltMonth: [12]string{"جنوري", "فبروري", "مارچ", "اپریل", "مۍ", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+		AltMonth:   [12]string{"جنوري", "فېبروري", "مارچ", "اپریل", "مۍ", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:       language.MustParse("pt-MO"),
+		DTfmt:     "%d/%m/%Y, %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		Day:       [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		AbMonth:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		Month:     [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	},
+	{
+		Tag:       language.MustParse("pt-PT"),
+		DTfmt:     "%d/%m/%Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		Day:       [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		AbMonth:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		Month:     [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	},
+	{
+		Tag:       language.MustParse("pt"),
+		DTfmt:     "%-d de %b de %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		Day:       [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		AbMonth:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		Month:     [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	},
+	{
+		Tag:       language.MustParse("qu"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"Dom", "Lun", "Mar", "Mié", "Jue", "Vie", "Sab"},
+		Day:       [7]string{"Domingo", "Lunes", "Martes", "Miércoles", "Jueves", "Viernes", "Sábado"},
+		AbMonth:   [12]string{"Ene", "Feb", "Mar", "Abr", "May", "Jun", "Jul", "Ago", "Set", "Oct", "Nov", "Dic"},
+		Month:     [12]string{"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto", "Setiembre", "Octubre", "Noviembre", "Diciembre"},
+	},
+	{
+		Tag:       language.MustParse("rm"),
+		DTfmt:     "%d-%m-%Y %H:%M:%S",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"du", "gli", "ma", "me", "gie", "ve", "so"},
+		Day:       [7]string{"dumengia", "glindesdi", "mardi", "mesemna", "gievgia", "venderdi", "sonda"},
+		AbMonth:   [12]string{"schan.", "favr.", "mars", "avr.", "matg", "zercl.", "fan.", "avust", "sett.", "oct.", "nov.", "dec."},
+		Month:     [12]string{"schaner", "favrer", "mars", "avrigl", "matg", "zercladur", "fanadur", "avust", "settember", "october", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("rn"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Z.MU.", "Z.MW."},
+		AbDay:     [7]string{"cu.", "mbe.", "kab.", "gtu.", "kan.", "gnu.", "gnd."},
+		Day:       [7]string{"Ku w’indwi", "Ku wa mbere", "Ku wa kabiri", "Ku wa gatatu", "Ku wa kane", "Ku wa gatanu", "Ku wa gatandatu"},
+		AbMonth:   [12]string{"Mut.", "Gas.", "Wer.", "Mat.", "Gic.", "Kam.", "Nya.", "Kan.", "Nze.", "Ukw.", "Ugu.", "Uku."},
+		Month:     [12]string{"Nzero", "Ruhuhuma", "Ntwarante", "Ndamukiza", "Rusama", "Ruheshi", "Mukakaro", "Nyandagaro", "Nyakanga", "Gitugutu", "Munyonyo", "Kigarama"},
+	},
+	{
+		Tag:       language.MustParse("ro-MD"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"Dum", "Lun", "Mar", "Mie", "Joi", "Vin", "Sâm"},
+		Day:       [7]string{"duminică", "luni", "marți", "miercuri", "joi", "vineri", "sâmbătă"},
+		AbMonth:   [12]string{"ian.", "feb.", "mar.", "apr.", "mai", "iun.", "iul.", "aug.", "sept.", "oct.", "nov.", "dec."},
+		Month:     [12]string{"ianuarie", "februarie", "martie", "aprilie", "mai", "iunie", "iulie", "august", "septembrie", "octombrie", "noiembrie", "decembrie"},
+	},
+	{
+		Tag:       language.MustParse("ro"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"a.m.", "p.m."},
+		AbDay:     [7]string{"dum.", "lun.", "mar.", "mie.", "joi", "vin.", "sâm."},
+		Day:       [7]string{"duminică", "luni", "marți", "miercuri", "joi", "vineri", "sâmbătă"},
+		AbMonth:   [12]string{"ian.", "feb.", "mar.", "apr.", "mai", "iun.", "iul.", "aug.", "sept.", "oct.", "nov.", "dec."},
+		Month:     [12]string{"ianuarie", "februarie", "martie", "aprilie", "mai", "iunie", "iulie", "august", "septembrie", "octombrie", "noiembrie", "decembrie"},
+	},
+	{
+		Tag:       language.MustParse("rof"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"kang’ama", "kingoto"},
+		AbDay:     [7]string{"Ijp", "Ijt", "Ijn", "Ijtn", "Alh", "Iju", "Ijm"},
+		Day:       [7]string{"Ijumapili", "Ijumatatu", "Ijumanne", "Ijumatano", "Alhamisi", "Ijumaa", "Ijumamosi"},
+		AbMonth:   [12]string{"M1", "M2", "M3", "M4", "M5", "M6", "M7", "M8", "M9", "M10", "M11", "M12"},
+		Month:     [12]string{"Mweri wa kwanza", "Mweri wa kaili", "Mweri wa katatu", "Mweri wa kaana", "Mweri wa tanu", "Mweri wa sita", "Mweri wa saba", "Mweri wa nane", "Mweri wa tisa", "Mweri wa ikumi", "Mweri wa ikumi na moja", "Mweri wa ikumi na mbili"},
+	},
+	{
+		Tag:        language.MustParse("ru-UA"),
+		DTfmt:      "%-d %b %Y г., %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		Day:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		AbMonth:    [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		Month:      [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		AbAltMonth: [12]string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		AltMonth:   [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	},
+	{
+		Tag:        language.MustParse("ru"),
+		DTfmt:      "%-d %b %Y г., %-H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		Day:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		AbMonth:    [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		Month:      [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		AbAltMonth: [12]string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		AltMonth:   [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	},
+	{
+		Tag:       language.MustParse("rw"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"cyu.", "mbe.", "kab.", "gtu.", "kan.", "gnu.", "gnd."},
+		Day:       [7]string{"Ku cyumweru", "Kuwa mbere", "Kuwa kabiri", "Kuwa gatatu", "Kuwa kane", "Kuwa gatanu", "Kuwa gatandatu"},
+		AbMonth:   [12]string{"mut.", "gas.", "wer.", "mat.", "gic.", "kam.", "nya.", "kan.", "nze.", "ukw.", "ugu.", "uku."},
+		Month:     [12]string{"Mutarama", "Gashyantare", "Werurwe", "Mata", "Gicuransi", "Kamena", "Nyakanga", "Kanama", "Nzeli", "Ukwakira", "Ugushyingo", "Ukuboza"},
+	},
+	{
+		Tag:       language.MustParse("rwk"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"utuko", "kyiukonyi"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jnn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Jumapilyi", "Jumatatuu", "Jumanne", "Jumatanu", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprilyi", "Mei", "Junyi", "Julyai", "Agusti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:        language.MustParse("sah"),
+		DTfmt:      "%Y, %b %-d %H:%M:%S",
+		Dfmt:       "%Y/%-m/%-d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y/%-m/%-d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"ЭИ", "ЭК"},
+		AbDay:      [7]string{"бс", "бн", "оп", "сэ", "чп", "бэ", "сб"},
+		Day:        [7]string{"баскыһыанньа", "бэнидиэнньик", "оптуорунньук", "сэрэдэ", "чэппиэр", "Бээтиҥсэ", "субуота"},
+		AbMonth:    [12]string{"Тохс", "Олун", "Клн", "Мсу", "Ыам", "Бэс", "Отй", "Атр", "Блҕ", "Алт", "Сэт", "Ахс"},
+		Month:      [12]string{"Тохсунньу", "Олунньу", "Кулун тутар", "Муус устар", "Ыам ыйын", "Бэс ыйын", "От ыйын", "Атырдьых ыйын", "Балаҕан ыйын", "Алтынньы", "Сэтинньи", "ахсынньы"},
+		AbAltMonth: [12]string{"Тохс", "Олун", "Клн", "Мсу", "Ыам", "Бэс", "Отй", "Атр", "Блҕ", "Алт", "Сэт", "Ахс"},
+		AltMonth:   [12]string{"тохсунньу", "олунньу", "кулун тутар", "муус устар", "ыам ыйа", "бэс ыйа", "от ыйа", "атырдьых ыйа", "балаҕан ыйа", "алтынньы", "сэтинньи", "ахсынньы"},
+	},
+	{
+		Tag:       language.MustParse("saq"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Tesiran", "Teipa"},
+		AbDay:     [7]string{"Are", "Kun", "Ong", "Ine", "Ile", "Sap", "Kwe"},
+		Day:       [7]string{"Mderot ee are", "Mderot ee kuni", "Mderot ee ong’wan", "Mderot ee inet", "Mderot ee ile", "Mderot ee sapa", "Mderot ee kwe"},
+		AbMonth:   [12]string{"Obo", "Waa", "Oku", "Ong", "Ime", "Ile", "Sap", "Isi", "Saa", "Tom", "Tob", "Tow"},
+		Month:     [12]string{"Lapa le obo", "Lapa le waare", "Lapa le okuni", "Lapa le ong’wan", "Lapa le imet", "Lapa le ile", "Lapa le sapa", "Lapa le isiet", "Lapa le saal", "Lapa le tomon", "Lapa le tomon obo", "Lapa le tomon waare"},
+	},
+	{
+		Tag:       language.MustParse("sbp"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Lwamilawu", "Pashamihe"},
+		AbDay:     [7]string{"Mul", "Jtt", "Jnn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Mulungu", "Jumatatu", "Jumanne", "Jumatano", "Alahamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Mup", "Mwi", "Msh", "Mun", "Mag", "Muj", "Msp", "Mpg", "Mye", "Mok", "Mus", "Muh"},
+		Month:     [12]string{"Mupalangulwa", "Mwitope", "Mushende", "Munyi", "Mushende Magali", "Mujimbi", "Mushipepo", "Mupuguto", "Munyense", "Mokhu", "Musongandembwe", "Muhaano"},
+	},
+	{
+		Tag:       language.MustParse("sd"),
+		DTfmt:     "%Y %b %-d %-I:%M:%S %p",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"صبح، منجهند", "شام، منجهند"},
+		AbDay:     [7]string{"آچر", "سومر", "اڱارو", "اربع", "خميس", "جمعو", "ڇنڇر"},
+		Day:       [7]string{"آچر", "سومر", "اڱارو", "اربع", "خميس", "جمعو", "ڇنڇر"},
+		AbMonth:   [12]string{"جنوري", "فيبروري", "مارچ", "اپريل", "مئي", "جون", "جولاءِ", "آگسٽ", "سيپٽمبر", "آڪٽوبر", "نومبر", "ڊسمبر"},
+		Month:     [12]string{"جنوري", "فيبروري", "مارچ", "اپريل", "مئي", "جون", "جولاءِ", "آگسٽ", "سيپٽمبر", "آڪٽوبر", "نومبر", "ڊسمبر"},
+	},
+	{
+		Tag:       language.MustParse("se-FI"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ib", "eb"},
+		AbDay:     [7]string{"so", "má", "di", "ga", "du", "be", "lá"},
+		Day:       [7]string{"sotnabeaivi", "mánnodat", "disdat", "gaskavahkku", "duorastat", "bearjadat", "lávvordat"},
+		AbMonth:   [12]string{"ođđj", "guov", "njuk", "cuoŋ", "mies", "geas", "suoi", "borg", "čakč", "golg", "skáb", "juov"},
+		Month:     [12]string{"ođđajagemánnu", "guovvamánnu", "njukčamánnu", "cuoŋománnu", "miessemánnu", "geassemánnu", "suoidnemánnu", "borgemánnu", "čakčamánnu", "golggotmánnu", "skábmamánnu", "juovlamánnu"},
+	},
+	{
+		Tag:       language.MustParse("se"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"i.b.", "e.b."},
+		AbDay:     [7]string{"sotn", "vuos", "maŋ", "gask", "duor", "bear", "láv"},
+		Day:       [7]string{"sotnabeaivi", "vuossárga", "maŋŋebárga", "gaskavahkku", "duorasdat", "bearjadat", "lávvardat"},
+		AbMonth:   [12]string{"ođđj", "guov", "njuk", "cuo", "mies", "geas", "suoi", "borg", "čakč", "golg", "skáb", "juov"},
+		Month:     [12]string{"ođđajagemánnu", "guovvamánnu", "njukčamánnu", "cuoŋománnu", "miessemánnu", "geassemánnu", "suoidnemánnu", "borgemánnu", "čakčamánnu", "golggotmánnu", "skábmamánnu", "juovlamánnu"},
+	},
+	{
+		Tag:       language.MustParse("seh"),
+		DTfmt:     "%-d de %b de %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Dim", "Pos", "Pir", "Tat", "Nai", "Sha", "Sab"},
+		Day:       [7]string{"Dimingu", "Chiposi", "Chipiri", "Chitatu", "Chinai", "Chishanu", "Sabudu"},
+		AbMonth:   [12]string{"Jan", "Fev", "Mar", "Abr", "Mai", "Jun", "Jul", "Aug", "Set", "Otu", "Nov", "Dec"},
+		Month:     [12]string{"Janeiro", "Fevreiro", "Marco", "Abril", "Maio", "Junho", "Julho", "Augusto", "Setembro", "Otubro", "Novembro", "Decembro"},
+	},
+	{
+		Tag:       language.MustParse("ses"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Adduha", "Aluula"},
+		AbDay:     [7]string{"Alh", "Ati", "Ata", "Ala", "Alm", "Alz", "Asi"},
+		Day:       [7]string{"Alhadi", "Atinni", "Atalaata", "Alarba", "Alhamiisa", "Alzuma", "Asibti"},
+		AbMonth:   [12]string{"Žan", "Fee", "Mar", "Awi", "Me", "Žuw", "Žuy", "Ut", "Sek", "Okt", "Noo", "Dee"},
+		Month:     [12]string{"Žanwiye", "Feewiriye", "Marsi", "Awiril", "Me", "Žuweŋ", "Žuyye", "Ut", "Sektanbur", "Oktoobur", "Noowanbur", "Deesanbur"},
+	},
+	{
+		Tag:       language.MustParse("sg"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ND", "LK"},
+		AbDay:     [7]string{"Bk1", "Bk2", "Bk3", "Bk4", "Bk5", "Lâp", "Lây"},
+		Day:       [7]string{"Bikua-ôko", "Bïkua-ûse", "Bïkua-ptâ", "Bïkua-usïö", "Bïkua-okü", "Lâpôsö", "Lâyenga"},
+		AbMonth:   [12]string{"Nye", "Ful", "Mbä", "Ngu", "Bêl", "Fön", "Len", "Kük", "Mvu", "Ngb", "Nab", "Kak"},
+		Month:     [12]string{"Nyenye", "Fulundïgi", "Mbängü", "Ngubùe", "Bêläwü", "Föndo", "Lengua", "Kükürü", "Mvuka", "Ngberere", "Nabändüru", "Kakauka"},
+	},
+	{
+		Tag:       language.MustParse("shi-Latn"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"tifawt", "tadggʷat"},
+		AbDay:     [7]string{"asa", "ayn", "asi", "akṛ", "akw", "asim", "asiḍ"},
+		Day:       [7]string{"asamas", "aynas", "asinas", "akṛas", "akwas", "asimwas", "asiḍyas"},
+		AbMonth:   [12]string{"inn", "bṛa", "maṛ", "ibr", "may", "yun", "yul", "ɣuc", "cut", "ktu", "nuw", "duj"},
+		Month:     [12]string{"innayr", "bṛayṛ", "maṛṣ", "ibrir", "mayyu", "yunyu", "yulyuz", "ɣuct", "cutanbir", "ktubr", "nuwanbir", "dujanbir"},
+	},
+	{
+		Tag:       language.MustParse("shi"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ⵜⵉⴼⴰⵡⵜ", "ⵜⴰⴷⴳⴳⵯⴰⵜ"},
+		AbDay:     [7]string{"ⴰⵙⴰ", "ⴰⵢⵏ", "ⴰⵙⵉ", "ⴰⴽⵕ", "ⴰⴽⵡ", "ⴰⵙⵉⵎ", "ⴰⵙⵉⴹ"},
+		Day:       [7]string{"ⴰⵙⴰⵎⴰⵙ", "ⴰⵢⵏⴰⵙ", "ⴰⵙⵉⵏⴰⵙ", "ⴰⴽⵕⴰⵙ", "ⴰⴽⵡⴰⵙ", "ⵙⵉⵎⵡⴰⵙ", "ⴰⵙⵉⴹⵢⴰⵙ"},
+		AbMonth:   [12]string{"ⵉⵏⵏ", "ⴱⵕⴰ", "ⵎⴰⵕ", "ⵉⴱⵔ", "ⵎⴰⵢ", "ⵢⵓⵏ", "ⵢⵓⵍ", "ⵖⵓⵛ", "ⵛⵓⵜ", "ⴽⵜⵓ", "ⵏⵓⵡ", "ⴷⵓⵊ"},
+		Month:     [12]string{"ⵉⵏⵏⴰⵢⵔ", "ⴱⵕⴰⵢⵕ", "ⵎⴰⵕⵚ", "ⵉⴱⵔⵉⵔ", "ⵎⴰⵢⵢⵓ", "ⵢⵓⵏⵢⵓ", "ⵢⵓⵍⵢⵓⵣ", "ⵖⵓⵛⵜ", "ⵛⵓⵜⴰⵏⴱⵉⵔ", "ⴽⵜⵓⴱⵔ", "ⵏⵓⵡⴰⵏⴱⵉⵔ", "ⴷⵓⵊⴰⵏⴱⵉⵔ"},
+	},
+	{
+		Tag:        language.MustParse("si"),
+		DTfmt:      "%Y %b %-d %H.%M.%S",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%H.%M.%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%H.%M",
+		AmPm:       [2]string{"පෙ.ව.", "ප.ව."},
+		AbDay:      [7]string{"ඉරිදා", "සඳුදා", "අඟහ", "බදාදා", "බ්\u200dරහස්", "සිකු", "සෙන"},
+		Day:        [7]string{"ඉරිදා", "සඳුදා", "අඟහරුවාදා", "බදාදා", "බ්\u200dරහස්පතින්දා", "සිකුරාදා", "සෙනසුරාදා"},
+		AbMonth:    [12]string{"ජන", "පෙබ", "මාර්තු", "අප්\u200dරේල්", "මැයි", "ජූනි", "ජූලි", "අගෝ", "සැප්", "ඔක්", "නොවැ", "දෙසැ"},
+		Month:      [12]string{"ජනවාරි", "පෙබරවාරි", "මාර්තු", "අප්\u200dරේල්", "මැයි", "ජූනි", "ජූලි", "අගෝස්තු", "සැප්තැම්බර්", "ඔක්තෝබර්", "නොවැම්බර්", "දෙසැම්බර්"},
+		AbAltMonth: [12]string{"ජන", "පෙබ", "මාර්", "අප්\u200dරේල්", "මැයි", "ජූනි", "ජූලි", "අගෝ", "සැප්", "ඔක්", "නොවැ", "දෙසැ"},
+		AltMonth:   [12]string{"ජනවාරි", "පෙබරවාරි", "මාර්තු", "අප්\u200dරේල්", "මැයි", "ජූනි", "ජූලි", "අගෝස්තු", "සැප්තැම්බර්", "ඔක්තෝබර්", "නොවැම්බර්", "දෙසැම්බර්"},
+	},
+	{
+		Tag:        language.MustParse("sk"),
+		DTfmt:      "%-d. %-m. %Y, %-H:%M:%S",
+		Dfmt:       "%-d. %-m. %Y",
+		Tfmt:       "%-H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d. %-m. %y",
+		TfmtShort:  "%-H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"ne", "po", "ut", "st", "št", "pi", "so"},
+		Day:        [7]string{"nedeľa", "pondelok", "utorok", "streda", "štvrtok", "piatok", "sobota"},
+		AbMonth:    [12]string{"jan", "feb", "mar", "apr", "máj", "jún", "júl", "aug", "sep", "okt", "nov", "dec"},
+		Month:      [12]string{"januára", "februára", "marca", "apríla", "mája", "júna", "júla", "augusta", "septembra", "októbra", "novembra", "decembra"},
+		AbAltMonth: [12]string{"jan", "feb", "mar", "apr", "máj", "jún", "júl", "aug", "sep", "okt", "nov", "dec"},
+		AltMonth:   [12]string{"január", "február", "marec", "apríl", "máj", "jún", "júl", "august", "september", "október", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("sl"),
+		DTfmt:     "%-d. %b %Y %H:%M:%S",
+		Dfmt:      "%-d. %m. %Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d. %m. %y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"dop.", "pop."},
+		AbDay:     [7]string{"ned.", "pon.", "tor.", "sre.", "čet.", "pet.", "sob."},
+		Day:       [7]string{"nedelja", "ponedeljek", "torek", "sreda", "četrtek", "petek", "sobota"},
+		AbMonth:   [12]string{"jan.", "feb.", "mar.", "apr.", "maj", "jun.", "jul.", "avg.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januar", "februar", "marec", "april", "maj", "junij", "julij", "avgust", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("smn"),
+		DTfmt:     "%b %-d. %Y tme %-H.%M.%S",
+		Dfmt:      "%-d.%-m.%Y",
+		Tfmt:      "%-H.%M.%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y",
+		TfmtShort: "%-H.%M",
+		AmPm:      [2]string{"ip.", "ep."},
+		AbDay:     [7]string{"pas", "vuo", "maj", "kos", "tuo", "vás", "láv"},
+		Day:       [7]string{"pasepeeivi", "vuossaargâ", "majebaargâ", "koskoho", "tuorâstuv", "vástuppeeivi", "lávurduv"},
+		AbMonth:   [12]string{"uđiv", "kuovâ", "njuhčâ", "cuáŋui", "vyesi", "kesi", "syeini", "porge", "čohčâ", "roovvâd", "skammâ", "juovlâ"},
+		Month:     [12]string{"uđđâivemáánu", "kuovâmáánu", "njuhčâmáánu", "cuáŋuimáánu", "vyesimáánu", "kesimáánu", "syeinimáánu", "porgemáánu", "čohčâmáánu", "roovvâdmáánu", "skammâmáánu", "juovlâmáánu"},
+	},
+	{
+		Tag:       language.MustParse("sn"),
+		DTfmt:     "%Y %b %-d %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Svo", "Muv", "Chp", "Cht", "Chn", "Chs", "Mug"},
+		Day:       [7]string{"Svondo", "Muvhuro", "Chipiri", "Chitatu", "China", "Chishanu", "Mugovera"},
+		AbMonth:   [12]string{"Ndi", "Kuk", "Kur", "Kub", "Chv", "Chk", "Chg", "Nya", "Gun", "Gum", "Mbu", "Zvi"},
+		Month:     [12]string{"Ndira", "Kukadzi", "Kurume", "Kubvumbi", "Chivabvu", "Chikumi", "Chikunguru", "Nyamavhuvhu", "Gunyana", "Gumiguru", "Mbudzi", "Zvita"},
+	},
+	{
+		Tag:       language.MustParse("so-KE"),
+		DTfmt:     "%d-%b-%Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"sn.", "gn."},
+		AbDay:     [7]string{"Axd", "Isn", "Tal", "Arb", "Kha", "Jim", "Sab"},
+		Day:       [7]string{"Axad", "Isniin", "Talaado", "Arbaco", "Khamiis", "Jimco", "Sabti"},
+		AbMonth:   [12]string{"Kob", "Lab", "Sad", "Afr", "Sha", "Lix", "Tod", "Sid", "Sag", "Tob", "KIT", "LIT"},
+		Month:     [12]string{"Bisha Koobaad", "Bisha Labaad", "Bisha Saddexaad", "Bisha Afraad", "Bisha Shanaad", "Bisha Lixaad", "Bisha Todobaad", "Bisha Sideedaad", "Bisha Sagaalaad", "Bisha Tobnaad", "Bisha Kow iyo Tobnaad", "Bisha Laba iyo Tobnaad"},
+	},
+	{
+		Tag:       language.MustParse("so"),
+		DTfmt:     "%d-%b-%Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"sn.", "gn."},
+		AbDay:     [7]string{"Axd", "Isn", "Tal", "Arb", "Kha", "Jim", "Sab"},
+		Day:       [7]string{"Axad", "Isniin", "Talaado", "Arbaco", "Khamiis", "Jimco", "Sabti"},
+		AbMonth:   [12]string{"Kob", "Lab", "Sad", "Afr", "Sha", "Lix", "Tod", "Sid", "Sag", "Tob", "KIT", "LIT"},
+		Month:     [12]string{"Bisha Koobaad", "Bisha Labaad", "Bisha Saddexaad", "Bisha Afraad", "Bisha Shanaad", "Bisha Lixaad", "Bisha Todobaad", "Bisha Sideedaad", "Bisha Sagaalaad", "Bisha Tobnaad", "Bisha Kow iyo Tobnaad", "Bisha Laba iyo Tobnaad"},
+	},
+	{
+		Tag:        language.MustParse("sq-MK"),
+		DTfmt:      "%-d %b %Y, %H:%M:%S",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"e paradites", "e pasdites"},
+		AbDay:      [7]string{"Die", "Hën", "Mar", "Mër", "Enj", "Pre", "Sht"},
+		Day:        [7]string{"e diel", "e hënë", "e martë", "e mërkurë", "e enjte", "e premte", "e shtunë"},
+		AbMonth:    [12]string{"jan", "shk", "mar", "pri", "maj", "qer", "korr", "gush", "sht", "tet", "nën", "dhj"},
+		Month:      [12]string{"janar", "shkurt", "mars", "prill", "maj", "qershor", "korrik", "gusht", "shtator", "tetor", "nëntor", "dhjetor"},
+		AbAltMonth: [12]string{"Jan", "Shk", "Mar", "Pri", "Maj", "Qer", "Korr", "Gush", "Sht", "Tet", "Nën", "Dhj"},
+		AltMonth:   [12]string{"Janar", "Shkurt", "Mars", "Prill", "Maj", "Qershor", "Korrik", "Gusht", "Shtator", "Tetor", "Nëntor", "Dhjetor"},
+	},
+	{
+		Tag:        language.MustParse("sq-XK"),
+		DTfmt:      "%-d %b %Y, %H:%M:%S",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"e paradites", "e pasdites"},
+		AbDay:      [7]string{"Die", "Hën", "Mar", "Mër", "Enj", "Pre", "Sht"},
+		Day:        [7]string{"e diel", "e hënë", "e martë", "e mërkurë", "e enjte", "e premte", "e shtunë"},
+		AbMonth:    [12]string{"jan", "shk", "mar", "pri", "maj", "qer", "korr", "gush", "sht", "tet", "nën", "dhj"},
+		Month:      [12]string{"janar", "shkurt", "mars", "prill", "maj", "qershor", "korrik", "gusht", "shtator", "tetor", "nëntor", "dhjetor"},
+		AbAltMonth: [12]string{"Jan", "Shk", "Mar", "Pri", "Maj", "Qer", "Korr", "Gush", "Sht", "Tet", "Nën", "Dhj"},
+		AltMonth:   [12]string{"Janar", "Shkurt", "Mars", "Prill", "Maj", "Qershor", "Korrik", "Gusht", "Shtator", "Tetor", "Nëntor", "Dhjetor"},
+	},
+	{
+		Tag:        language.MustParse("sq"),
+		DTfmt:      "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:       "%-d.%-m.%Y",
+		Tfmt:       "%-I:%M:%S %p",
+		Tfmt12:     "%-I:%M:%S %p",
+		DfmtShort:  "%-d.%-m.%y",
+		TfmtShort:  "%-I:%M %p",
+		AmPm:       [2]string{"e paradites", "e pasdites"},
+		AbDay:      [7]string{"Die", "Hën", "Mar", "Mër", "Enj", "Pre", "Sht"},
+		Day:        [7]string{"e diel", "e hënë", "e martë", "e mërkurë", "e enjte", "e premte", "e shtunë"},
+		AbMonth:    [12]string{"jan", "shk", "mar", "pri", "maj", "qer", "korr", "gush", "sht", "tet", "nën", "dhj"},
+		Month:      [12]string{"janar", "shkurt", "mars", "prill", "maj", "qershor", "korrik", "gusht", "shtator", "tetor", "nëntor", "dhjetor"},
+		AbAltMonth: [12]string{"Jan", "Shk", "Mar", "Pri", "Maj", "Qer", "Korr", "Gush", "Sht", "Tet", "Nën", "Dhj"},
+		AltMonth:   [12]string{"Janar", "Shkurt", "Mars", "Prill", "Maj", "Qershor", "Korrik", "Gusht", "Shtator", "Tetor", "Nëntor", "Dhjetor"},
+	},
+	{
+		Tag:       language.MustParse("sr-Cyrl-BA"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"прије подне", "по подне"},
+		AbDay:     [7]string{"нед.", "пон.", "ут.", "ср.", "чет.", "пет.", "суб."},
+		Day:       [7]string{"недјеља", "понедељак", "уторак", "сриједа", "четвртак", "петак", "субота"},
+		AbMonth:   [12]string{"јан.", "феб.", "март", "апр.", "мај", "јун", "јул", "авг.", "септ.", "окт.", "нов.", "дец."},
+		Month:     [12]string{"јануар", "фебруар", "март", "април", "мај", "јун", "јул", "август", "септембар", "октобар", "новембар", "децембар"},
+	},
+	{
+		Tag:       language.MustParse("sr-Cyrl-ME"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"прије подне", "по подне"},
+		AbDay:     [7]string{"нед.", "пон.", "ут.", "ср.", "чет.", "пет.", "суб."},
+		Day:       [7]string{"недјеља", "понедељак", "уторак", "сриједа", "четвртак", "петак", "субота"},
+		AbMonth:   [12]string{"јан.", "феб.", "март", "апр.", "мај", "јун", "јул", "авг.", "септ.", "окт.", "нов.", "дец."},
+		Month:     [12]string{"јануар", "фебруар", "март", "април", "мај", "јун", "јул", "август", "септембар", "октобар", "новембар", "децембар"},
+	},
+	{
+		Tag:       language.MustParse("sr-Cyrl-XK"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"пре подне", "по подне"},
+		AbDay:     [7]string{"нед.", "пон.", "ут.", "ср.", "чет.", "пет.", "суб."},
+		Day:       [7]string{"недеља", "понедељак", "уторак", "среда", "четвртак", "петак", "субота"},
+		AbMonth:   [12]string{"јан.", "феб.", "март", "апр.", "мај", "јун", "јул", "авг.", "септ.", "окт.", "нов.", "дец."},
+		Month:     [12]string{"јануар", "фебруар", "март", "април", "мај", "јун", "јул", "август", "септембар", "октобар", "новембар", "децембар"},
+	},
+	{
+		Tag:       language.MustParse("sr-Latn-BA"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"prije podne", "po podne"},
+		AbDay:     [7]string{"ned.", "pon.", "ut.", "sr.", "čet.", "pet.", "sub."},
+		Day:       [7]string{"nedjelja", "ponedeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		AbMonth:   [12]string{"jan.", "feb.", "mart", "apr.", "maj", "jun", "jul", "avg.", "sept.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januar", "februar", "mart", "april", "maj", "jun", "jul", "avgust", "septembar", "oktobar", "novembar", "decembar"},
+	},
+	{
+		Tag:       language.MustParse("sr-Latn-ME"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"prije podne", "po podne"},
+		AbDay:     [7]string{"ned.", "pon.", "ut.", "sr.", "čet.", "pet.", "sub."},
+		Day:       [7]string{"nedjelja", "ponedeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"},
+		AbMonth:   [12]string{"jan.", "feb.", "mart", "apr.", "maj", "jun", "jul", "avg.", "sept.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januar", "februar", "mart", "april", "maj", "jun", "jul", "avgust", "septembar", "oktobar", "novembar", "decembar"},
+	},
+	{
+		Tag:       language.MustParse("sr-Latn-XK"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"pre podne", "po podne"},
+		AbDay:     [7]string{"ned.", "pon.", "ut.", "sr.", "čet.", "pet.", "sub."},
+		Day:       [7]string{"nedelja", "ponedeljak", "utorak", "sreda", "četvrtak", "petak", "subota"},
+		AbMonth:   [12]string{"jan.", "feb.", "mart", "apr.", "maj", "jun", "jul", "avg.", "sept.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januar", "februar", "mart", "april", "maj", "jun", "jul", "avgust", "septembar", "oktobar", "novembar", "decembar"},
+	},
+	{
+		Tag:       language.MustParse("sr-Latn"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"pre podne", "po podne"},
+		AbDay:     [7]string{"ned", "pon", "uto", "sre", "čet", "pet", "sub"},
+		Day:       [7]string{"nedelja", "ponedeljak", "utorak", "sreda", "četvrtak", "petak", "subota"},
+		AbMonth:   [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "avg", "sep", "okt", "nov", "dec"},
+		Month:     [12]string{"januar", "februar", "mart", "april", "maj", "jun", "jul", "avgust", "septembar", "oktobar", "novembar", "decembar"},
+	},
+	{
+		Tag:       language.MustParse("sr"),
+		DTfmt:     "%d.%m.%Y. %H:%M:%S",
+		Dfmt:      "%-d.%-m.%Y.",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%-m.%y.",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"пре подне", "по подне"},
+		AbDay:     [7]string{"нед", "пон", "уто", "сре", "чет", "пет", "суб"},
+		Day:       [7]string{"недеља", "понедељак", "уторак", "среда", "четвртак", "петак", "субота"},
+		AbMonth:   [12]string{"јан", "феб", "мар", "апр", "мај", "јун", "јул", "авг", "сеп", "окт", "нов", "дец"},
+		Month:     [12]string{"јануар", "фебруар", "март", "април", "мај", "јун", "јул", "август", "септембар", "октобар", "новембар", "децембар"},
+	},
+	{
+		Tag:       language.MustParse("sv-FI"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"fm", "em"},
+		AbDay:     [7]string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"},
+		Day:       [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		AbMonth:   [12]string{"jan.", "feb.", "mars", "apr.", "maj", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("sv"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"fm", "em"},
+		AbDay:     [7]string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"},
+		Day:       [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		AbMonth:   [12]string{"jan.", "feb.", "mars", "apr.", "maj", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "dec."},
+		Month:     [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+	},
+	{
+		Tag:       language.MustParse("sw-KE"),
+		DTfmt:     "%-d %b %Y, %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		Day:       [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprili", "Mei", "Juni", "Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("sw"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		Day:       [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprili", "Mei", "Juni", "Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("ta-LK"),
+		DTfmt:     "%-d %b, %Y, %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"முற்பகல்", "பிற்பகல்"},
+		AbDay:     [7]string{"ஞாயி.", "திங்.", "செவ்.", "புத.", "வியா.", "வெள்.", "சனி"},
+		Day:       [7]string{"ஞாயிறு", "திங்கள்", "செவ்வாய்", "புதன்", "வியாழன்", "வெள்ளி", "சனி"},
+		AbMonth:   [12]string{"ஜன.", "பிப்.", "மார்.", "ஏப்.", "மே", "ஜூன்", "ஜூலை", "ஆக.", "செப்.", "அக்.", "நவ.", "டிச."},
+		Month:     [12]string{"ஜனவரி", "பிப்ரவரி", "மார்ச்", "ஏப்ரல்", "மே", "ஜூன்", "ஜூலை", "ஆகஸ்ட்", "செப்டம்பர்", "அக்டோபர்", "நவம்பர்", "டிசம்பர்"},
+	},
+	{
+		Tag:       language.MustParse("ta"),
+		DTfmt:     "%-d %b, %Y, %p %-I:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%p %-I:%M:%S",
+		Tfmt12:    "%p %-I:%M:%S",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%p %-I:%M",
+		AmPm:      [2]string{"முற்பகல்", "பிற்பகல்"},
+		AbDay:     [7]string{"ஞாயி.", "திங்.", "செவ்.", "புத.", "வியா.", "வெள்.", "சனி"},
+		Day:       [7]string{"ஞாயிறு", "திங்கள்", "செவ்வாய்", "புதன்", "வியாழன்", "வெள்ளி", "சனி"},
+		AbMonth:   [12]string{"ஜன.", "பிப்.", "மார்.", "ஏப்.", "மே", "ஜூன்", "ஜூலை", "ஆக.", "செப்.", "அக்.", "நவ.", "டிச."},
+		Month:     [12]string{"ஜனவரி", "பிப்ரவரி", "மார்ச்", "ஏப்ரல்", "மே", "ஜூன்", "ஜூலை", "ஆகஸ்ட்", "செப்டம்பர்", "அக்டோபர்", "நவம்பர்", "டிசம்பர்"},
+	},
+	{
+		Tag:       language.MustParse("te"),
+		DTfmt:     "%-d %b, %Y %-I:%M:%S %p",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"ఆది", "సోమ", "మంగళ", "బుధ", "గురు", "శుక్ర", "శని"},
+		Day:       [7]string{"ఆదివారం", "సోమవారం", "మంగళవారం", "బుధవారం", "గురువారం", "శుక్రవారం", "శనివారం"},
+		AbMonth:   [12]string{"జన", "ఫిబ్ర", "మార్చి", "ఏప్రి", "మే", "జూన్", "జులై", "ఆగ", "సెప్టెం", "అక్టో", "నవం", "డిసెం"},
+		Month:     [12]string{"జనవరి", "ఫిబ్రవరి", "మార్చి", "ఏప్రిల్", "మే", "జూన్", "జులై", "ఆగస్టు", "సెప్టెంబర్", "అక్టోబర్", "నవంబర్", "డిసెంబర్"},
+	},
+	{
+		Tag:       language.MustParse("teo"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Taparachu", "Ebongi"},
+		AbDay:     [7]string{"Jum", "Bar", "Aar", "Uni", "Ung", "Kan", "Sab"},
+		Day:       [7]string{"Nakaejuma", "Nakaebarasa", "Nakaare", "Nakauni", "Nakaung’on", "Nakakany", "Nakasabiti"},
+		AbMonth:   [12]string{"Rar", "Muk", "Kwa", "Dun", "Mar", "Mod", "Jol", "Ped", "Sok", "Tib", "Lab", "Poo"},
+		Month:     [12]string{"Orara", "Omuk", "Okwamg’", "Odung’el", "Omaruk", "Omodok’king’ol", "Ojola", "Opedel", "Osokosokoma", "Otibar", "Olabor", "Opoo"},
+	},
+	{
+		Tag:       language.MustParse("tg"),
+		DTfmt:     "%d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"пе. чо.", "па. чо."},
+		AbDay:     [7]string{"Яшб", "Дшб", "Сшб", "Чшб", "Пшб", "Ҷмъ", "Шнб"},
+		Day:       [7]string{"Якшанбе", "Душанбе", "Сешанбе", "Чоршанбе", "Панҷшанбе", "Ҷумъа", "Шанбе"},
+		AbMonth:   [12]string{"Янв", "Фев", "Мар", "Апр", "Май", "Июн", "Июл", "Авг", "Сен", "Окт", "Ноя", "Дек"},
+		Month:     [12]string{"Январ", "Феврал", "Март", "Апрел", "Май", "Июн", "Июл", "Август", "Сентябр", "Октябр", "Ноябр", "Декабр"},
+	},
+	{
+		Tag:       language.MustParse("th"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ก่อนเที่ยง", "หลังเที่ยง"},
+		AbDay:     [7]string{"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."},
+		Day:       [7]string{"วันอาทิตย์", "วันจันทร์", "วันอังคาร", "วันพุธ", "วันพฤหัสบดี", "วันศุกร์", "วันเสาร์"},
+		AbMonth:   [12]string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."},
+		Month:     [12]string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"},
+	},
+	{
+		Tag:       language.MustParse("ti"),
+		DTfmt:     "%d-%b-%Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ንጉሆ ሰዓተ", "ድሕር ሰዓት"},
+		AbDay:     [7]string{"ሰን", "ሰኑ", "ሰሉ", "ረቡ", "ሓሙ", "ዓር", "ቀዳ"},
+		Day:       [7]string{"ሰንበት", "ሰኑይ", "ሠሉስ", "ረቡዕ", "ኃሙስ", "ዓርቢ", "ቀዳም"},
+		AbMonth:   [12]string{"ጥሪ", "ለካ", "መጋ", "ሚያ", "ግን", "ሰነ", "ሓም", "ነሓ", "መስ", "ጥቅ", "ሕዳ", "ታሕ"},
+		Month:     [12]string{"ጥሪ", "ለካቲት", "መጋቢት", "ሚያዝያ", "ግንቦት", "ሰነ", "ሓምለ", "ነሓሰ", "መስከረም", "ጥቅምቲ", "ሕዳር", "ታሕሳስ"},
+	},
+	{
+		Tag:        language.MustParse("tk"),
+		DTfmt:      "%-d %b %Y %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"go.öň", "go.soň"},
+		AbDay:      [7]string{"ýek", "duş", "siş", "çar", "pen", "ann", "şen"},
+		Day:        [7]string{"ýekşenbe", "duşenbe", "sişenbe", "çarşenbe", "penşenbe", "anna", "şenbe"},
+		AbMonth:    [12]string{"ýan", "few", "mart", "apr", "maý", "iýun", "iýul", "awg", "sen", "okt", "noý", "dek"},
+		Month:      [12]string{"ýanwar", "fewral", "mart", "aprel", "maý", "iýun", "iýul", "awgust", "sentýabr", "oktýabr", "noýabr", "dekabr"},
+		AbAltMonth: [12]string{"Ýan", "Few", "Mart", "Apr", "Maý", "Iýun", "Iýul", "Awg", "Sen", "Okt", "Noý", "Dek"},
+		AltMonth:   [12]string{"Ýanwar", "Fewral", "Mart", "Aprel", "Maý", "Iýun", "Iýul", "Awgust", "Sentýabr", "Oktýabr", "Noýabr", "Dekabr"},
+	},
+	{
+		Tag:       language.MustParse("to"),
+		DTfmt:     "%-d %b %Y, %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sāp", "Mōn", "Tūs", "Pul", "Tuʻa", "Fal", "Tok"},
+		Day:       [7]string{"Sāpate", "Mōnite", "Tūsite", "Pulelulu", "Tuʻapulelulu", "Falaite", "Tokonaki"},
+		AbMonth:   [12]string{"Sān", "Fēp", "Maʻa", "ʻEpe", "Mē", "Sun", "Siu", "ʻAok", "Sep", "ʻOka", "Nōv", "Tīs"},
+		Month:     [12]string{"Sānuali", "Fēpueli", "Maʻasi", "ʻEpeleli", "Mē", "Sune", "Siulai", "ʻAokosi", "Sepitema", "ʻOkatopa", "Nōvema", "Tīsema"},
+	},
+	{
+		Tag:       language.MustParse("tr-CY"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%-d.%m.%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d.%m.%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"ÖÖ", "ÖS"},
+		AbDay:     [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+		Day:       [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+		AbMonth:   [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+		Month:     [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+	},
+	{
+		Tag:       language.MustParse("tr"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d.%m.%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d.%m.%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ÖÖ", "ÖS"},
+		AbDay:     [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+		Day:       [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+		AbMonth:   [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+		Month:     [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+	},
+	{
+		Tag:       language.MustParse("tt"),
+		DTfmt:     "%-d %b, %Y ел, %-H:%M:%S",
+		Dfmt:      "%d.%m.%Y",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d.%m.%y",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"якш.", "дүш.", "сиш.", "чәр.", "пәнҗ.", "җом.", "шим."},
+		Day:       [7]string{"якшәмбе", "дүшәмбе", "сишәмбе", "чәршәмбе", "пәнҗешәмбе", "җомга", "шимбә"},
+		AbMonth:   [12]string{"гыйн.", "фев.", "мар.", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		Month:     [12]string{"гыйнвар", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	},
+	{
+		Tag:       language.MustParse("twq"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Subbaahi", "Zaarikay b"},
+		AbDay:     [7]string{"Alh", "Ati", "Ata", "Ala", "Alm", "Alz", "Asi"},
+		Day:       [7]string{"Alhadi", "Atinni", "Atalaata", "Alarba", "Alhamiisa", "Alzuma", "Asibti"},
+		AbMonth:   [12]string{"Žan", "Fee", "Mar", "Awi", "Me", "Žuw", "Žuy", "Ut", "Sek", "Okt", "Noo", "Dee"},
+		Month:     [12]string{"Žanwiye", "Feewiriye", "Marsi", "Awiril", "Me", "Žuweŋ", "Žuyye", "Ut", "Sektanbur", "Oktoobur", "Noowanbur", "Deesanbur"},
+	},
+	{
+		Tag:       language.MustParse("tzm"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Zdat azal", "Ḍeffir aza"},
+		AbDay:     [7]string{"Asa", "Ayn", "Asn", "Akr", "Akw", "Asm", "Asḍ"},
+		Day:       [7]string{"Asamas", "Aynas", "Asinas", "Akras", "Akwas", "Asimwas", "Asiḍyas"},
+		AbMonth:   [12]string{"Yen", "Yeb", "Mar", "Ibr", "May", "Yun", "Yul", "Ɣuc", "Cut", "Kṭu", "Nwa", "Duj"},
+		Month:     [12]string{"Yennayer", "Yebrayer", "Mars", "Ibrir", "Mayyu", "Yunyu", "Yulyuz", "Ɣuct", "Cutanbir", "Kṭuber", "Nwanbir", "Dujanbir"},
+	},
+	{
+		Tag:       language.MustParse("ug"),
+		DTfmt:     "%-d-%b، %Y، %-I:%M:%S %p",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"چ.ب", "چ.ك"},
+		AbDay:     [7]string{"يە", "دۈ", "سە", "چا", "پە", "جۈ", "شە"},
+		Day:       [7]string{"يەكشەنبە", "دۈشەنبە", "سەيشەنبە", "چارشەنبە", "پەيشەنبە", "جۈمە", "شەنبە"},
+		AbMonth:   [12]string{"يانۋار", "فېۋرال", "مارت", "ئاپرېل", "ماي", "ئىيۇن", "ئىيۇل", "ئاۋغۇست", "سېنتەبىر", "ئۆكتەبىر", "نويابىر", "دېكابىر"},
+		Month:     [12]string{"يانۋار", "فېۋرال", "مارت", "ئاپرېل", "ماي", "ئىيۇن", "ئىيۇل", "ئاۋغۇست", "سېنتەبىر", "ئۆكتەبىر", "نويابىر", "دېكابىر"},
+	},
+	{
+		Tag:        language.MustParse("uk"),
+		DTfmt:      "%-d %b %Y р., %H:%M:%S",
+		Dfmt:       "%d.%m.%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d.%m.%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"дп", "пп"},
+		AbDay:      [7]string{"нд", "пн", "вт", "ср", "чт", "пт", "сб"},
+		Day:        [7]string{"неділя", "понеділок", "вівторок", "середа", "четвер", "пʼятниця", "субота"},
+		AbMonth:    [12]string{"січ.", "лют.", "бер.", "квіт.", "трав.", "черв.", "лип.", "серп.", "вер.", "жовт.", "лист.", "груд."},
+		Month:      [12]string{"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
+		AbAltMonth: [12]string{"січ", "лют", "бер", "кві", "тра", "чер", "лип", "сер", "вер", "жов", "лис", "гру"},
+		AltMonth:   [12]string{"січень", "лютий", "березень", "квітень", "травень", "червень", "липень", "серпень", "вересень", "жовтень", "листопад", "грудень"},
+	},
+	{
+		Tag:       language.MustParse("ur"),
+		DTfmt:     "%b %-d, %Y %-I:%M:%S %p",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"اتوار", "پیر", "منگل", "بدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		Day:       [7]string{"اتوار", "پیر", "منگل", "بدھ", "جمعرات", "جمعہ", "ہفتہ"},
+		AbMonth:   [12]string{"جنوری", "فروری", "مارچ", "اپریل", "مئی", "جون", "جولائی", "اگست", "ستمبر", "اکتوبر", "نومبر", "دسمبر"},
+		Month:     [12]string{"جنوری", "فروری", "مارچ", "اپریل", "مئی", "جون", "جولائی", "اگست", "ستمبر", "اکتوبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:       language.MustParse("uz-Arab"),
+		DTfmt:     "%-d %b %Y %-H:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%-H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%-H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"ی.", "د.", "س.", "چ.", "پ.", "ج.", "ش."},
+		Day:       [7]string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		AbMonth:   [12]string{"جنو", "فبر", "مار", "اپر", "می", "جون", "جول", "اگس", "سپت", "اکت", "نوم", "دسم"},
+		Month:     [12]string{"جنوری", "فبروری", "مارچ", "اپریل", "می", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+	},
+	{
+		Tag:        language.MustParse("uz-Cyrl"),
+		DTfmt:      "%-d %b, %Y %H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"ТО", "ТК"},
+		AbDay:      [7]string{"якш", "душ", "сеш", "чор", "пай", "жум", "шан"},
+		Day:        [7]string{"якшанба", "душанба", "сешанба", "чоршанба", "пайшанба", "жума", "шанба"},
+		AbMonth:    [12]string{"янв", "фев", "мар", "апр", "май", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
+		Month:      [12]string{"январ", "феврал", "март", "апрел", "май", "июн", "июл", "август", "сентябр", "октябр", "ноябр", "декабр"},
+		AbAltMonth: [12]string{"Янв", "Фев", "Мар", "Апр", "Май", "Июн", "Июл", "Авг", "Сен", "Окт", "Ноя", "Дек"},
+		AltMonth:   [12]string{"Январ", "Феврал", "Март", "Апрел", "Май", "Июн", "Июл", "Август", "Сентябр", "Октябр", "Ноябр", "Декабр"},
+	},
+	{
+		Tag:        language.MustParse("uz"),
+		DTfmt:      "%-d-%b, %Y, %H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"TO", "TK"},
+		AbDay:      [7]string{"Yak", "Dush", "Sesh", "Chor", "Pay", "Jum", "Shan"},
+		Day:        [7]string{"yakshanba", "dushanba", "seshanba", "chorshanba", "payshanba", "juma", "shanba"},
+		AbMonth:    [12]string{"yan", "fev", "mar", "apr", "may", "iyn", "iyl", "avg", "sen", "okt", "noy", "dek"},
+		Month:      [12]string{"yanvar", "fevral", "mart", "aprel", "may", "iyun", "iyul", "avgust", "sentabr", "oktabr", "noyabr", "dekabr"},
+		AbAltMonth: [12]string{"Yan", "Fev", "Mar", "Apr", "May", "Iyn", "Iyl", "Avg", "Sen", "Okt", "Noy", "Dek"},
+		AltMonth:   [12]string{"Yanvar", "Fevral", "Mart", "Aprel", "May", "Iyun", "Iyul", "Avgust", "Sentabr", "Oktabr", "Noyabr", "Dekabr"},
+	},
+	{
+		Tag:       language.MustParse("vai-Latn"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"lahadi", "tɛɛnɛɛ", "talata", "alaba", "aimisa", "aijima", "siɓiti"},
+		Day:       [7]string{"lahadi", "tɛɛnɛɛ", "talata", "alaba", "aimisa", "aijima", "siɓiti"},
+		AbMonth:   [12]string{"luukao kemã", "ɓandaɓu", "vɔɔ", "fulu", "goo", "6", "7", "kɔnde", "saah", "galo", "kenpkato ɓololɔ", "luukao lɔma"},
+		Month:     [12]string{"luukao kemã", "ɓandaɓu", "vɔɔ", "fulu", "goo", "6", "7", "kɔnde", "saah", "galo", "kenpkato ɓololɔ", "luukao lɔma"},
+	},
+	{
+		Tag:       language.MustParse("vai"),
+		DTfmt:     "%-d %b %Y %-I:%M:%S %p",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%-I:%M:%S %p",
+		Tfmt12:    "%-I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%-I:%M %p",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"ꕞꕌꔵ", "ꗳꗡꘉ", "ꕚꕞꕚ", "ꕉꕞꕒ", "ꕉꔤꕆꕢ", "ꕉꔤꕀꕮ", "ꔻꔬꔳ"},
+		Day:       [7]string{"ꕞꕌꔵ", "ꗳꗡꘉ", "ꕚꕞꕚ", "ꕉꕞꕒ", "ꕉꔤꕆꕢ", "ꕉꔤꕀꕮ", "ꔻꔬꔳ"},
+		AbMonth:   [12]string{"ꖨꕪꖃ", "ꕒꕡ", "ꕾꖺ", "ꖢꖕ", "ꖑꕱ", "ꖱꘋ", "ꖱꕞ", "ꗛꔕ", "ꕢꕌ", "ꕭꖃ", "ꔞꘋ", "ꖨꕪꕱ"},
+		Month:     [12]string{"ꖨꕪꖃ ꔞꕮ", "ꕒꕡꖝꖕ", "ꕾꖺ", "ꖢꖕ", "ꖑꕱ", "ꖱꘋ", "ꖱꕞꔤ", "ꗛꔕ", "ꕢꕌ", "ꕭꖃ", "ꔞꘋꕔꕿ ꕸꖃꗏ", "ꖨꕪꕱ ꗏꕮ"},
+	},
+	{
+		Tag:        language.MustParse("vi"),
+		DTfmt:      "%H:%M:%S, %-d %b, %Y",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"SA", "CH"},
+		AbDay:      [7]string{"CN", "Th 2", "Th 3", "Th 4", "Th 5", "Th 6", "Th 7"},
+		Day:        [7]string{"Chủ Nhật", "Thứ Hai", "Thứ Ba", "Thứ Tư", "Thứ Năm", "Thứ Sáu", "Thứ Bảy"},
+		AbMonth:    [12]string{"thg 1", "thg 2", "thg 3", "thg 4", "thg 5", "thg 6", "thg 7", "thg 8", "thg 9", "thg 10", "thg 11", "thg 12"},
+		Month:      [12]string{"tháng 1", "tháng 2", "tháng 3", "tháng 4", "tháng 5", "tháng 6", "tháng 7", "tháng 8", "tháng 9", "tháng 10", "tháng 11", "tháng 12"},
+		AbAltMonth: [12]string{"Thg 1", "Thg 2", "Thg 3", "Thg 4", "Thg 5", "Thg 6", "Thg 7", "Thg 8", "Thg 9", "Thg 10", "Thg 11", "Thg 12"},
+		AltMonth:   [12]string{"Tháng 1", "Tháng 2", "Tháng 3", "Tháng 4", "Tháng 5", "Tháng 6", "Tháng 7", "Tháng 8", "Tháng 9", "Tháng 10", "Tháng 11", "Tháng 12"},
+	},
+	{
+		Tag:        language.MustParse("vo"),
+		DTfmt:      "%Y %b. %-d %H:%M:%S",
+		Dfmt:       "%Y-%m-%d",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%y-%m-%d",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"AM", "PM"},
+		AbDay:      [7]string{"su.", "mu.", "tu.", "ve.", "dö.", "fr.", "zä."},
+		Day:        [7]string{"sudel", "mudel", "tudel", "vedel", "dödel", "fridel", "zädel"},
+		AbMonth:    [12]string{"yan", "feb", "mäz", "prl", "may", "yun", "yul", "gst", "set", "ton", "nov", "dek"},
+		Month:      [12]string{"yanul", "febul", "mäzul", "prilul", "mayul", "yunul", "yulul", "gustul", "setul", "tobul", "novul", "dekul"},
+		AbAltMonth: [12]string{"yan", "feb", "mäz", "prl", "may", "yun", "yul", "gst", "set", "tob", "nov", "dek"},
+		AltMonth:   [12]string{"yanul", "febul", "mäzul", "prilul", "mayul", "yunul", "yulul", "gustul", "setul", "tobul", "novul", "dekul"},
+	},
+	{
+		Tag:       language.MustParse("vun"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"utuko", "kyiukonyi"},
+		AbDay:     [7]string{"Jpi", "Jtt", "Jnn", "Jtn", "Alh", "Iju", "Jmo"},
+		Day:       [7]string{"Jumapilyi", "Jumatatuu", "Jumanne", "Jumatanu", "Alhamisi", "Ijumaa", "Jumamosi"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mac", "Apr", "Mei", "Jun", "Jul", "Ago", "Sep", "Okt", "Nov", "Des"},
+		Month:     [12]string{"Januari", "Februari", "Machi", "Aprilyi", "Mei", "Junyi", "Julyai", "Agusti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("wae"),
+		DTfmt:     "%-d. %b %Y %H:%M:%S",
+		Dfmt:      "%Y-%m-%d",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%y-%m-%d",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Sun", "Män", "Ziš", "Mit", "Fró", "Fri", "Sam"},
+		Day:       [7]string{"Sunntag", "Mäntag", "Zištag", "Mittwuč", "Fróntag", "Fritag", "Samštag"},
+		AbMonth:   [12]string{"Jen", "Hor", "Mär", "Abr", "Mei", "Brá", "Hei", "Öig", "Her", "Wím", "Win", "Chr"},
+		Month:     [12]string{"Jenner", "Hornig", "Märze", "Abrille", "Meije", "Bráčet", "Heiwet", "Öigšte", "Herbštmánet", "Wímánet", "Wintermánet", "Chrištmánet"},
+	},
+	{
+		Tag:       language.MustParse("wo"),
+		DTfmt:     "%-d %b, %Y - %H:%M:%S",
+		Dfmt:      "%d-%m-%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d-%m-%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Sub", "Ngo"},
+		AbDay:     [7]string{"Dib", "Alt", "Tal", "Àla", "Alx", "Àjj", "Ase"},
+		Day:       [7]string{"Dibéer", "Altine", "Talaata", "Àlarba", "Alxamis", "Àjjuma", "Aseer"},
+		AbMonth:   [12]string{"Sam", "Few", "Mar", "Awr", "Mee", "Suw", "Sul", "Ut", "Sàt", "Okt", "Now", "Des"},
+		Month:     [12]string{"Samwiyee", "Fewriyee", "Mars", "Awril", "Mee", "Suwe", "Sulet", "Ut", "Sàttumbar", "Oktoobar", "Nowàmbar", "Desàmbar"},
+	},
+	{
+		Tag:       language.MustParse("xog"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Munkyo", "Eigulo"},
+		AbDay:     [7]string{"Sabi", "Bala", "Kubi", "Kusa", "Kuna", "Kuta", "Muka"},
+		Day:       [7]string{"Sabiiti", "Balaza", "Owokubili", "Owokusatu", "Olokuna", "Olokutaanu", "Olomukaaga"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mar", "Apu", "Maa", "Juu", "Jul", "Agu", "Seb", "Oki", "Nov", "Des"},
+		Month:     [12]string{"Janwaliyo", "Febwaliyo", "Marisi", "Apuli", "Maayi", "Juuni", "Julaayi", "Agusito", "Sebuttemba", "Okitobba", "Novemba", "Desemba"},
+	},
+	{
+		Tag:       language.MustParse("yav"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"kiɛmɛ́ɛm", "kisɛ́ndɛ"},
+		AbDay:     [7]string{"sd", "md", "mw", "et", "kl", "fl", "ss"},
+		Day:       [7]string{"sɔ́ndiɛ", "móndie", "muányáŋmóndie", "metúkpíápɛ", "kúpélimetúkpiapɛ", "feléte", "séselé"},
+		AbMonth:   [12]string{"o.1", "o.2", "o.3", "o.4", "o.5", "o.6", "o.7", "o.8", "o.9", "o.10", "o.11", "o.12"},
+		Month:     [12]string{"pikítíkítie, oólí ú kutúan", "siɛyɛ́, oóli ú kándíɛ", "ɔnsúmbɔl, oóli ú kátátúɛ", "mesiŋ, oóli ú kénie", "ensil, oóli ú kátánuɛ", "ɔsɔn", "efute", "pisuyú", "imɛŋ i puɔs", "imɛŋ i putúk,oóli ú kátíɛ", "makandikɛ", "pilɔndɔ́"},
+	},
+	{
+		Tag:        language.MustParse("yi"),
+		DTfmt:      "%-dטן %b %Y, %H:%M:%S",
+		Dfmt:       "%d/%m/%Y",
+		Tfmt:       "%H:%M:%S",
+		Tfmt12:     "%I:%M:%S %p",
+		DfmtShort:  "%d/%m/%y",
+		TfmtShort:  "%H:%M",
+		AmPm:       [2]string{"פֿאַרמיטאָג", "נאָכמיטאָג"},
+		AbDay:      [7]string{"זונטיק", "מאָנטיק", "דינסטיק", "מיטוואך", "דאנערשטיק", "פֿרײַטיק", "שבת"},
+		Day:        [7]string{"זונטיק", "מאָנטיק", "דינסטיק", "מיטוואך", "דאנערשטיק", "פֿרײַטיק", "שבת"},
+		AbMonth:    [12]string{"יאַנואַר", "פֿעברואַר", "מערץ", "אַפּריל", "מיי", "יוני", "יולי", "אויגוסט", "סעפּטעמבער", "אקטאבער", "נאוועמבער", "דעצעמבער"},
+		Month:      [12]string{"יאַנואַר", "פֿעברואַר", "מערץ", "אַפּריל", "מיי", "יוני", "יולי", "אויגוסט", "סעפּטעמבער", "אקטאבער", "נאוועמבער", "דעצעמבער"},
+		AbAltMonth: [12]string{"יאַנ", "פֿעב", "מערץ", "אַפּר", "מיי", "יוני", "יולי", "אויג", "סעפּ", "אקט", "נאוו", "דעצ"},
+		AltMonth:   [12]string{"יאַנואַר", "פֿעברואַר", "מערץ", "אַפּריל", "מיי", "יוני", "יולי", "אויגוסט", "סעפּטעמבער", "אקטאבער", "נאוועמבער", "דעצעמבער"},
+	},
+	{
+		Tag:       language.MustParse("yo-BJ"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Àárɔ̀", "Ɔ̀sán"},
+		AbDay:     [7]string{"Àìkú", "Ajé", "Ìsɛ́gun", "Ɔjɔ́rú", "Ɔjɔ́bɔ", "Ɛtì", "Àbámɛ́ta"},
+		Day:       [7]string{"Ɔjɔ́ Àìkú", "Ɔjɔ́ Ajé", "Ɔjɔ́ Ìsɛ́gun", "Ɔjɔ́rú", "Ɔjɔ́bɔ", "Ɔjɔ́ Ɛtì", "Ɔjɔ́ Àbámɛ́ta"},
+		AbMonth:   [12]string{"Shɛ́rɛ́", "Èrèlè", "Ɛrɛ̀nà", "Ìgbé", "Ɛ̀bibi", "Òkúdu", "Agɛmɔ", "Ògún", "Owewe", "Ɔ̀wàrà", "Bélú", "Ɔ̀pɛ̀"},
+		Month:     [12]string{"Oshù Shɛ́rɛ́", "Oshù Èrèlè", "Oshù Ɛrɛ̀nà", "Oshù Ìgbé", "Oshù Ɛ̀bibi", "Oshù Òkúdu", "Oshù Agɛmɔ", "Oshù Ògún", "Oshù Owewe", "Oshù Ɔ̀wàrà", "Oshù Bélú", "Oshù Ɔ̀pɛ̀"},
+	},
+	{
+		Tag:       language.MustParse("yo"),
+		DTfmt:     "%-d %b %Y %H:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"Àárọ̀", "Ọ̀sán"},
+		AbDay:     [7]string{"Àìkú", "Ajé", "Ìsẹ́gun", "Ọjọ́rú", "Ọjọ́bọ", "Ẹtì", "Àbámẹ́ta"},
+		Day:       [7]string{"Ọjọ́ Àìkú", "Ọjọ́ Ajé", "Ọjọ́ Ìsẹ́gun", "Ọjọ́rú", "Ọjọ́bọ", "Ọjọ́ Ẹtì", "Ọjọ́ Àbámẹ́ta"},
+		AbMonth:   [12]string{"Ṣẹ́rẹ́", "Èrèlè", "Ẹrẹ̀nà", "Ìgbé", "Ẹ̀bibi", "Òkúdu", "Agẹmọ", "Ògún", "Owewe", "Ọ̀wàrà", "Bélú", "Ọ̀pẹ̀"},
+		Month:     [12]string{"Oṣù Ṣẹ́rẹ́", "Oṣù Èrèlè", "Oṣù Ẹrẹ̀nà", "Oṣù Ìgbé", "Oṣù Ẹ̀bibi", "Oṣù Òkúdu", "Oṣù Agẹmọ", "Oṣù Ògún", "Oṣù Owewe", "Oṣù Ọ̀wàrà", "Oṣù Bélú", "Oṣù Ọ̀pẹ̀"},
+	},
+	{
+		Tag:       language.MustParse("yue-Hans"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	},
+	{
+		Tag:       language.MustParse("yue"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	},
+	{
+		Tag:       language.MustParse("zgh"),
+		DTfmt:     "%-d %b, %Y %H:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"ⵜⵉⴼⴰⵡⵜ", "ⵜⴰⴷⴳⴳⵯⴰⵜ"},
+		AbDay:     [7]string{"ⴰⵙⴰ", "ⴰⵢⵏ", "ⴰⵙⵉ", "ⴰⴽⵕ", "ⴰⴽⵡ", "ⴰⵙⵉⵎ", "ⴰⵙⵉⴹ"},
+		Day:       [7]string{"ⴰⵙⴰⵎⴰⵙ", "ⴰⵢⵏⴰⵙ", "ⴰⵙⵉⵏⴰⵙ", "ⴰⴽⵕⴰⵙ", "ⴰⴽⵡⴰⵙ", "ⴰⵙⵉⵎⵡⴰⵙ", "ⴰⵙⵉⴹⵢⴰⵙ"},
+		AbMonth:   [12]string{"ⵉⵏⵏ", "ⴱⵕⴰ", "ⵎⴰⵕ", "ⵉⴱⵔ", "ⵎⴰⵢ", "ⵢⵓⵏ", "ⵢⵓⵍ", "ⵖⵓⵛ", "ⵛⵓⵜ", "ⴽⵜⵓ", "ⵏⵓⵡ", "ⴷⵓⵊ"},
+		Month:     [12]string{"ⵉⵏⵏⴰⵢⵔ", "ⴱⵕⴰⵢⵕ", "ⵎⴰⵕⵚ", "ⵉⴱⵔⵉⵔ", "ⵎⴰⵢⵢⵓ", "ⵢⵓⵏⵢⵓ", "ⵢⵓⵍⵢⵓⵣ", "ⵖⵓⵛⵜ", "ⵛⵓⵜⴰⵏⴱⵉⵔ", "ⴽⵜⵓⴱⵔ", "ⵏⵓⵡⴰⵏⴱⵉⵔ", "ⴷⵓⵊⴰⵏⴱⵉⵔ"},
+	},
+	{
+		Tag:       language.MustParse("zh-Hans-HK"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	},
+	{
+		Tag:       language.MustParse("zh-Hans-MO"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	},
+	{
+		Tag:       language.MustParse("zh-Hans-SG"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%d/%m/%Y",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%d/%m/%y",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	},
+	{
+		Tag:       language.MustParse("zh-Hant-HK"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%-d/%-m/%Y",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%-d/%-m/%y",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	},
+	{
+		Tag:       language.MustParse("zh-Hant"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	},
+	{
+		Tag:       language.MustParse("zh"),
+		DTfmt:     "%Y年%-m月%-d日 %p%-I:%M:%S",
+		Dfmt:      "%Y/%-m/%-d",
+		Tfmt:      "%p%-I:%M:%S",
+		Tfmt12:    "%p%-I:%M:%S",
+		DfmtShort: "%y/%-m/%-d",
+		TfmtShort: "%p%-I:%M",
+		AmPm:      [2]string{"上午", "下午"},
+		AbDay:     [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		Day:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbMonth:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Month:     [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	},
+	{
+		Tag:       language.MustParse("zu"),
+		DTfmt:     "%b %-d, %Y %H:%M:%S",
+		Dfmt:      "%-m/%-d/%Y",
+		Tfmt:      "%H:%M:%S",
+		Tfmt12:    "%I:%M:%S %p",
+		DfmtShort: "%-m/%-d/%y",
+		TfmtShort: "%H:%M",
+		AmPm:      [2]string{"AM", "PM"},
+		AbDay:     [7]string{"Son", "Mso", "Bil", "Tha", "Sin", "Hla", "Mgq"},
+		Day:       [7]string{"ISonto", "UMsombuluko", "ULwesibili", "ULwesithathu", "ULwesine", "ULwesihlanu", "UMgqibelo"},
+		AbMonth:   [12]string{"Jan", "Feb", "Mas", "Eph", "Mey", "Jun", "Jul", "Aga", "Sep", "Okt", "Nov", "Dis"},
+		Month:     [12]string{"Januwari", "Februwari", "Mashi", "Ephreli", "Meyi", "Juni", "Julayi", "Agasti", "Septhemba", "Okthoba", "Novemba", "Disemba"},
+	},
+}
+
+// init adds the CLDR locales that have not been defined by hand
+func init() {
+	known := make(map[language.Tag]bool, len(strftimeLocales))
+	for _, l := range strftimeLocales {
+		known[cldrScriptTag(l.Tag)] = true
+	}
+	for _, l := range cldrLocales {
+		if !known[cldrScriptTag(l.Tag)] {
+			strftimeLocales = append(strftimeLocales, l)
+		}
+	}
+	rebuildLocales()
+}
+
+// cldrScriptTag returns tag with its likely script, as CLDR omits the script of the
+// locales where it is the default (zh for zh-Hans, zh-Hant-HK for zh-HK).
+func cldrScriptTag(tag language.Tag) language.Tag {
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	parts := []interface{}{base, script}
+	if region, conf := tag.Region(); conf == language.Exact {
+		parts = append(parts, region)
+	}
+	if t, err := language.Compose(parts...); err == nil {
+		return t
+	}
+	return tag
+}
@@ -1,7 +1,15 @@
 // Package strftime implements C-like strftime functionality with locale support.
 package strftime
 
-import "golang.org/x/text/language"
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
 
 // Note: The main difference between Simplified Chinese (时) and Traditional Chinese (時)
 // is the character used for "hour" in time formatting.
@@ -9,14 +17,19 @@ import "golang.org/x/text/language"
 var (
 	// simplifiedChineseLocale defines the Simplified Chinese (Mandarin) locale information
 	// for formatting dates and times according to Chinese conventions.
-	simplifiedChineseLocale = &strftimeLocaleInfo{
-		tag:    language.SimplifiedChinese,
+	simplifiedChineseLocale = &Locale{
+		Tag:    language.SimplifiedChinese,
 		DTfmt:  "%Y年%m月%d日 %A %H时%M分%S秒", // Date and time format (note the 时 character for hour)
 		Dfmt:   "%Y年%m月%d日",              // Date format
 		Tfmt:   "%H时%M分%S秒",              // Time format
 		Tfmt12: "%p %I时%M分%S秒",           // 12-hour time format
 		AmPm:   [2]string{"上午", "下午"},    // AM/PM indicators (morning/afternoon)
 
+		Numbering: "hans", // Chinese numerals for %O, e.g. 十二月三日
+
+		DfmtShort: "%y/%m/%d", // Short date format, e.g. 06/01/02
+		TfmtShort: "%H时%M分",   // Hours and minutes format
+
 		// Weekday names - abbreviated versions are just the day numbers in Chinese
 		AbDay: [7]string{"日", "一", "二", "三", "四", "五", "六"},               // Sun, Mon, Tue, etc.
 		Day:   [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"}, // Sunday, Monday, etc.
@@ -29,14 +42,19 @@ var (
 
 	// traditionalChineseLocale defines the Traditional Chinese locale information for formatting
 	// dates and times (used primarily in Taiwan, Hong Kong, and Macau).
-	traditionalChineseLocale = &strftimeLocaleInfo{
-		tag:    language.TraditionalChinese,
+	traditionalChineseLocale = &Locale{
+		Tag:    language.TraditionalChinese,
 		DTfmt:  "%Y年%m月%d日 (%A) %H時%M分%S秒", // Date and time format (note the 時 character for hour)
 		Dfmt:   "%Y年%m月%d日",                // Date format
 		Tfmt:   "%H時%M分%S秒",                // Time format
 		Tfmt12: "%p %I時%M分%S秒",             // 12-hour time format
 		AmPm:   [2]string{"上午", "下午"},      // AM/PM indicators (morning/afternoon)
 
+		Numbering: "hant", // Chinese numerals for %O, e.g. 十二月三日
+
+		DfmtShort: "%y/%m/%d", // Short date format, e.g. 06/01/02
+		TfmtShort: "%H時%M分",   // Hours and minutes format
+
 		// Weekday names - abbreviated versions are just the day numbers in Chinese
 		AbDay: [7]string{"日", "一", "二", "三", "四", "五", "六"},               // Sun, Mon, Tue, etc.
 		Day:   [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"}, // Sunday, Monday, etc.
@@ -46,4 +64,526 @@ var (
 		// Full month names use Chinese numerals
 		Month: [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
 	}
+
+	// taiwaneseChineseLocale defines the Traditional Chinese locale as used in Taiwan. Era
+	// formats use the Republic of China (Minguo) calendar found on invoices and government
+	// forms; the rest is inherited from traditionalChineseLocale.
+	taiwaneseChineseLocale = &Locale{
+		Tag:      language.MustParse("zh-TW"),
+		DTfmtEra: "%EY%m月%d日 (%A) %H時%M分%S秒", // Date and time format with era, e.g. 民國95年01月02日
+		DfmtEra:  "%EY%m月%d日",                // Date format with era
+		Calendar: minguoCalendar{},           // Republic of China calendar
+	}
+
+	// hongKongChineseLocale defines the Traditional Chinese locale as used in Hong Kong.
+	// Only the short date differs, with the day first; the rest is inherited from
+	// traditionalChineseLocale.
+	hongKongChineseLocale = &Locale{
+		Tag:       language.MustParse("zh-HK"),
+		DfmtShort: "%d/%m/%y", // Short date format, e.g. 02/01/06
+	}
+)
+
+// minguoCalendar is the Republic of China (Minguo) calendar, which counts years from
+// the founding of the Republic in 1912. The first year is called 民國元年, and years
+// before 1912 are counted backwards as 民國前 (era 1).
+type minguoCalendar struct{}
+
+// Date returns the date of t, with the Minguo year.
+func (minguoCalendar) Date(t time.Time) CalendarDate {
+	d := gregorianDate(t)
+	d.Year -= 1911
+	if d.Year < 1 {
+		// years before the founding of the Republic
+		d.Era, d.Year = 1, 1-d.Year
+	}
+	return d
+}
+
+// AppendEra appends the era name ('C'), the year within the era ('y', 'r' and 'k'), or
+// both ('Y').
+func (minguoCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	if base, _ := tag.Base(); base != chineseBase {
+		return appendEnglishMinguoEra(b, d, c)
+	}
+	era := "民國"
+	if d.Era == 1 {
+		era = "民國前"
+	}
+
+	switch c {
+	case 'C':
+		return append(b, era...), true // Return just the era name
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true // Return just the year within era
+	case 'Y':
+		b = append(b, era...)
+		if d.Year == 1 && d.Era == 0 {
+			// First year of the Republic is called "元年"
+			return append(b, "元年"...), true
+		}
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(b, "年"...), true
+	}
+	return b, false
+}
+
+// appendEnglishMinguoEra appends the era name or year of d like AppendEra does, with the
+// English era names Minguo and B.R.O.C. (before the Republic of China).
+func appendEnglishMinguoEra(b []byte, d CalendarDate, c byte) ([]byte, bool) {
+	era := "Minguo"
+	if d.Era == 1 {
+		era = "B.R.O.C."
+	}
+
+	switch c {
+	case 'C':
+		return append(b, era...), true
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true
+	case 'Y':
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(append(b, ' '), era...), true
+	}
+	return b, false
+}
+
+// gregorianYear returns the Gregorian year of year y of era (1 for years before the
+// Republic).
+func (minguoCalendar) gregorianYear(era, y int) int {
+	if era == 1 {
+		return 1912 - y
+	}
+	return y + 1911
+}
+
+// eras returns the eras of the Minguo calendar: the years of the Republic (0) and those
+// before it (1).
+func (minguoCalendar) eras() []int {
+	return []int{0, 1}
+}
+
+// MonthName returns an empty string, as months are the Gregorian ones.
+func (minguoCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return ""
+}
+
+// zhDigits are the Chinese digits, used digit by digit for years (二〇〇六年)
+var zhDigits = [10]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// strftimeSimplifiedChineseDigit converts a numeric value into Chinese numerals with
+// simplified characters (hans numbering system). For example, 12 becomes "十二" and
+// 105 becomes "一百零五".
+//
+// Parameters:
+//   - b: Byte slice to append the formatted result to
+//   - v: Integer value to convert to Chinese numerals
+//
+// Returns: The byte slice with Chinese numerals appended
+func strftimeSimplifiedChineseDigit(b []byte, v int) []byte {
+	return appendChineseNumber(b, v, [2]string{"万", "亿"})
+}
+
+// strftimeTraditionalChineseDigit converts a numeric value into Chinese numerals with
+// traditional characters (hant numbering system), which only differ from the simplified
+// ones for the units of ten thousand (萬) and hundred million (億).
+//
+// Parameters:
+//   - b: Byte slice to append the formatted result to
+//   - v: Integer value to convert to Chinese numerals
+//
+// Returns: The byte slice with Chinese numerals appended
+func strftimeTraditionalChineseDigit(b []byte, v int) []byte {
+	return appendChineseNumber(b, v, [2]string{"萬", "億"})
+}
+
+// appendChineseNumber appends v in Chinese numerals, using the given characters for ten
+// thousand and hundred million. Digits are grouped by four: 十, 百 and 千 are used within
+// a group, and 零 marks skipped positions (一百零五). A leading ten is written 十 rather
+// than 一十 (十二, but 一百一十二).
+func appendChineseNumber(b []byte, v int, large [2]string) []byte {
+	if v < 0 {
+		// Generally shouldn't happen in date formatting
+		b = append(b, '-')
+		v = -v
+	}
+	if v == 0 {
+		return append(b, "零"...)
+	}
+
+	units := [...]string{"", "十", "百", "千"}
+	groups := [...]struct {
+		value int64
+		unit  string
+	}{{1e16, large[1] + large[1]}, {1e8, large[1]}, {1e4, large[0]}, {1, ""}}
+
+	started, zero := false, false
+	for _, g := range groups {
+		n := int(int64(v) / g.value % 10000)
+		if n == 0 {
+			zero = started
+			continue
+		}
+		for i, d := 3, 1000; i >= 0; i, d = i-1, d/10 {
+			digit := n / d % 10
+			if digit == 0 {
+				zero = zero || started
+				continue
+			}
+			if zero {
+				b = append(b, "零"...)
+				zero = false
+			}
+			if !(digit == 1 && i == 1 && !started) {
+				b = append(b, zhDigits[digit]...)
+			}
+			b = append(b, units[i]...)
+			started = true
+		}
+		b = append(b, g.unit...)
+	}
+	return b
+}
+
+// chineseCalendar is the Chinese lunisolar calendar (农历), or its Korean variant, the
+// Dangi calendar, when korean is set. Months start on the day of the new moon, the
+// winter solstice always falls in the 11th month, and years with 13 months between two
+// 11th months repeat the first month without a major solar term (中气) as a leap month.
+// Dates are computed from the positions of the Sun and the Moon in the time of Beijing
+// (Seoul for the Dangi calendar), which matches the published tables for 1900 to 2100.
+//
+// Years are the Gregorian years in which they start, and are named with the sexagenary
+// cycle (甲辰). Besides the usual E conversions, the calendar supports %EZ (zodiac
+// animal of the year), %ED (day of the month, as in 初八), %ES (current solar term) and
+// %Es (solar term starting on that day, empty on other days).
+type chineseCalendar struct {
+	korean bool
+}
+
+// chineseSui holds the months of a Chinese year from winter solstice to winter solstice
+// (岁): the Julian day numbers of their first day (the last one is the first day of the
+// next 11th month), their number and whether they are leap months, and the index of the
+// first month of the year.
+type chineseSui struct {
+	starts  []int
+	numbers []int
+	leap    []bool
+	first   int
+}
+
+var (
+	// chineseSuiCacheLock protects chineseSuiCache
+	chineseSuiCacheLock sync.RWMutex
+
+	// chineseSuiCache holds the computed years of the Chinese and Dangi calendars
+	chineseSuiCache = map[chineseSuiKey]*chineseSui{}
+)
+
+// chineseSuiKey identifies a year of the Chinese or Dangi calendar in chineseSuiCache
+type chineseSuiKey struct {
+	korean bool
+	year   int
+}
+
+// zoneOffset returns the offset from UTC in days of the time used by the calendar at
+// Julian day jd: Beijing mean time (116°25′E) then China Standard Time since 1929, and
+// the successive standard times of Korea.
+func (c chineseCalendar) zoneOffset(jd float64) float64 {
+	y := 2000 + (jd-2451544.5)/365.2425
+	switch {
+	case !c.korean && y < 1929:
+		return (116 + 25.0/60) / 360
+	case !c.korean:
+		return 8.0 / 24
+	case y < 1908:
+		return (126 + 58.0/60) / 360 // Seoul mean time
+	case y < 1912, y >= 1954.22 && y < 1961.61:
+		return 8.5 / 24
+	}
+	return 9.0 / 24
+}
+
+// localDay returns the Julian day number of the day containing Julian day jd, in the
+// time of the calendar.
+func (c chineseCalendar) localDay(jd float64) int {
+	return int(math.Floor(jd + 0.5 + c.zoneOffset(jd)))
+}
+
+// midnight returns the Julian day of the start of day jdn, in the time of the calendar.
+func (c chineseCalendar) midnight(jdn int) float64 {
+	jd := float64(jdn) - 0.5
+	return jd - c.zoneOffset(jd)
+}
+
+// monthStart returns the first day of the month containing day jdn, and its lunation.
+func (c chineseCalendar) monthStart(jdn int) (int, int) {
+	k := newMoonK(c.midnight(jdn+1)) - 1
+	return c.localDay(newMoon(k)), k
+}
+
+// majorTerm returns the major solar term in effect at the start of day jdn, from 0 (春分,
+// spring equinox) to 11.
+func (c chineseCalendar) majorTerm(jdn int) int {
+	return int(sunLongitude(c.midnight(jdn)) / 30)
+}
+
+// sui returns the months from the 11th month of Gregorian year y-1 to the 11th month of
+// year y.
+func (c chineseCalendar) sui(y int) *chineseSui {
+	key := chineseSuiKey{c.korean, y}
+	chineseSuiCacheLock.RLock()
+	s, ok := chineseSuiCache[key]
+	chineseSuiCacheLock.RUnlock()
+	if ok {
+		return s
+	}
+
+	solstice := func(y int) int {
+		jd := solarLongitudeAfter(julianDay(time.Date(y, time.December, 1, 0, 0, 0, 0, time.UTC)), 270)
+		return c.localDay(jd)
+	}
+	start, k := c.monthStart(solstice(y - 1))
+	end, _ := c.monthStart(solstice(y))
+
+	s = &chineseSui{first: -1}
+	for jdn := start; jdn < end; k++ {
+		s.starts = append(s.starts, jdn)
+		jdn = c.localDay(newMoon(k + 1))
+	}
+	s.starts = append(s.starts, end)
+
+	leapYear, month := len(s.starts) == 14, 11
+	for i := range s.starts[:len(s.starts)-1] {
+		leap := false
+		switch {
+		case i == 0:
+		case leapYear && c.majorTerm(s.starts[i]) == c.majorTerm(s.starts[i+1]):
+			// first month without a major solar term
+			leap, leapYear = true, false
+		default:
+			month = month%12 + 1
+		}
+		if month == 1 && !leap && s.first == -1 {
+			s.first = i
+		}
+		s.numbers = append(s.numbers, month)
+		s.leap = append(s.leap, leap)
+	}
+
+	chineseSuiCacheLock.Lock()
+	chineseSuiCache[key] = s
+	chineseSuiCacheLock.Unlock()
+	return s
+}
+
+// Date returns the date of t in the Chinese calendar, with Year set to the Gregorian
+// year in which the Chinese year starts.
+func (c chineseCalendar) Date(t time.Time) CalendarDate {
+	y, m, d := t.Date()
+	jdn := dayNumber(y, m, d)
+	s := c.sui(y)
+	if jdn >= s.starts[len(s.starts)-1] {
+		y++
+		s = c.sui(y)
+	}
+	i := 0
+	for jdn >= s.starts[i+1] {
+		i++
+	}
+	if i < s.first {
+		y--
+	}
+	return CalendarDate{Time: t, Year: y, Month: s.numbers[i], Day: jdn - s.starts[i] + 1, LeapMonth: s.leap[i]}
+}
+
+// chineseLanguage returns the names used for tag: simplified or traditional Chinese,
+// Korean, or English.
+func chineseLanguage(tag language.Tag) *chineseNameSet {
+	base, _ := tag.Base()
+	switch base {
+	case chineseBase:
+		if script, _ := tag.Script(); script == traditionalScript {
+			return &chineseNames[1]
+		}
+		return &chineseNames[0]
+	case koreanBase:
+		return &chineseNames[2]
+	}
+	return &chineseNames[3]
+}
+
+var (
+	chineseBase, _       = language.Chinese.Base()
+	koreanBase, _        = language.Korean.Base()
+	traditionalScript, _ = language.TraditionalChinese.Script()
 )
+
+// chineseNameSet holds the names used by the Chinese calendar in a language.
+type chineseNameSet struct {
+	stems, branches  [10]string // heavenly stems, and the first 10 earthly branches
+	branches2        [2]string  // last 2 earthly branches
+	zodiac           [12]string // animals of the earthly branches
+	months, abMonths [12]string // month names
+	sep              string     // separator between stem and branch
+	leap             string     // format of leap month names
+	year             string     // suffix of the sexagenary year, as in 甲辰年
+	terms            [24]string // solar terms, from the spring equinox
+	days             func(b []byte, d int) []byte
+}
+
+// chineseNames holds the names of the Chinese calendar in simplified and traditional
+// Chinese, Korean and English.
+var chineseNames = [4]chineseNameSet{
+	{
+		stems:     [10]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"},
+		branches:  [10]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉"},
+		branches2: [2]string{"戌", "亥"},
+		zodiac:    [12]string{"鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"},
+		months:    [12]string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"},
+		abMonths:  [12]string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"},
+		leap:      "闰%s",
+		year:      "年",
+		terms:     [24]string{"春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至", "小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至", "小寒", "大寒", "立春", "雨水", "惊蛰"},
+		days:      appendChineseDay,
+	},
+	{
+		stems:     [10]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"},
+		branches:  [10]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉"},
+		branches2: [2]string{"戌", "亥"},
+		zodiac:    [12]string{"鼠", "牛", "虎", "兔", "龍", "蛇", "馬", "羊", "猴", "雞", "狗", "豬"},
+		months:    [12]string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "臘月"},
+		abMonths:  [12]string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "臘月"},
+		leap:      "閏%s",
+		year:      "年",
+		terms:     [24]string{"春分", "清明", "穀雨", "立夏", "小滿", "芒種", "夏至", "小暑", "大暑", "立秋", "處暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至", "小寒", "大寒", "立春", "雨水", "驚蟄"},
+		days:      appendChineseDay,
+	},
+	{
+		stems:     [10]string{"갑", "을", "병", "정", "무", "기", "경", "신", "임", "계"},
+		branches:  [10]string{"자", "축", "인", "묘", "진", "사", "오", "미", "신", "유"},
+		branches2: [2]string{"술", "해"},
+		zodiac:    [12]string{"쥐", "소", "호랑이", "토끼", "용", "뱀", "말", "양", "원숭이", "닭", "개", "돼지"},
+		months:    [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		abMonths:  [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		leap:      "윤%s",
+		year:      "년",
+		terms:     [24]string{"춘분", "청명", "곡우", "입하", "소만", "망종", "하지", "소서", "대서", "입추", "처서", "백로", "추분", "한로", "상강", "입동", "소설", "대설", "동지", "소한", "대한", "입춘", "우수", "경칩"},
+		days: func(b []byte, d int) []byte {
+			return append(strconv.AppendInt(b, int64(d), 10), "일"...)
+		},
+	},
+	{
+		stems:     [10]string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"},
+		branches:  [10]string{"zi", "chou", "yin", "mao", "chen", "si", "wu", "wei", "shen", "you"},
+		branches2: [2]string{"xu", "hai"},
+		zodiac:    [12]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"},
+		months:    [12]string{"First Month", "Second Month", "Third Month", "Fourth Month", "Fifth Month", "Sixth Month", "Seventh Month", "Eighth Month", "Ninth Month", "Tenth Month", "Eleventh Month", "Twelfth Month"},
+		abMonths:  [12]string{"Mo1", "Mo2", "Mo3", "Mo4", "Mo5", "Mo6", "Mo7", "Mo8", "Mo9", "Mo10", "Mo11", "Mo12"},
+		sep:       "-",
+		leap:      "Leap %s",
+		terms:     [24]string{"Spring Equinox", "Clear and Bright", "Grain Rain", "Start of Summer", "Grain Full", "Grain in Ear", "Summer Solstice", "Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox", "Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice", "Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects"},
+		days: func(b []byte, d int) []byte {
+			return strconv.AppendInt(b, int64(d), 10)
+		},
+	},
+}
+
+// branch returns the name of earthly branch i.
+func (n *chineseNameSet) branch(i int) string {
+	if i >= 10 {
+		return n.branches2[i-10]
+	}
+	return n.branches[i]
+}
+
+// appendCyclicYear appends the sexagenary name of year y, as in 甲辰 for 2024.
+func (n *chineseNameSet) appendCyclicYear(b []byte, y int) []byte {
+	i := ((y-4)%60 + 60) % 60
+	b = append(append(b, n.stems[i%10]...), n.sep...)
+	return append(b, n.branch(i%12)...)
+}
+
+// appendChineseDay appends day d of a Chinese month: 初一 to 初十, 十一 to 二十, 廿一 to 廿九
+// and 三十.
+func appendChineseDay(b []byte, d int) []byte {
+	switch {
+	case d <= 10:
+		b = append(b, "初"...)
+	case d < 20:
+		b = append(b, "十"...)
+	case d == 20:
+		return append(b, "二十"...)
+	case d < 30:
+		b = append(b, "廿"...)
+	default:
+		return append(b, "三十"...)
+	}
+	if d == 10 {
+		return append(b, "十"...)
+	}
+	return append(b, zhDigits[d%10]...)
+}
+
+// solarTerms returns the solar terms in effect at the start and at the end of day jdn,
+// from 0 (春分, spring equinox) to 23.
+func (c chineseCalendar) solarTerms(jdn int) (start, end int) {
+	return int(sunLongitude(c.midnight(jdn)) / 15), int(sunLongitude(c.midnight(jdn+1)) / 15)
+}
+
+// AppendEra appends the sexagenary name of the year ('C', as in 甲辰), the year ('y',
+// 'r' and 'k'), the sexagenary year ('Y', as in 甲辰年), the zodiac animal of the year
+// ('Z'), the day of the month ('D'), the current solar term ('S') or the solar term
+// starting on that day ('s').
+func (c chineseCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, conv byte) ([]byte, bool) {
+	n := chineseLanguage(tag)
+	switch conv {
+	case 'C':
+		return n.appendCyclicYear(b, d.Year), true
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true
+	case 'Y':
+		if n.year == "" {
+			// English: 2024 (Jia-chen)
+			b = strconv.AppendInt(b, int64(d.Year), 10)
+			return append(n.appendCyclicYear(append(b, " ("...), d.Year), ')'), true
+		}
+		return append(n.appendCyclicYear(b, d.Year), n.year...), true
+	case 'Z':
+		return append(b, n.zodiac[((d.Year-4)%12+12)%12]...), true
+	case 'D':
+		return n.days(b, d.Day), true
+	case 'S', 's':
+		y, m, day := d.Time.Date()
+		start, end := c.solarTerms(dayNumber(y, m, day))
+		if conv == 'S' || start != end {
+			b = append(b, n.terms[end]...)
+		}
+		return b, true
+	}
+	return b, false
+}
+
+// MonthName returns the name of the Chinese month, as in 腊月 or 闰四月.
+func (chineseCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	n := chineseLanguage(tag)
+	name := n.months[d.Month-1]
+	if abbrev {
+		name = n.abMonths[d.Month-1]
+	}
+	if d.LeapMonth {
+		return strings.Replace(n.leap, "%s", name, 1)
+	}
+	return name
+}
+
+// eraFormats returns the date and time, date and time formats of the calendar for
+// locale l, in its language.
+func (chineseCalendar) eraFormats(l *Locale) (dt, d, t string) {
+	switch chineseLanguage(l.Tag) {
+	case &chineseNames[0], &chineseNames[1]:
+		return "%EY%EB%ED %T", "%EY%EB%ED", ""
+	case &chineseNames[2]:
+		return "%EY %EB %ED %T", "%EY %EB %ED", ""
+	}
+	return "%EB %ED, %EY %T", "%EB %ED, %EY", ""
+}
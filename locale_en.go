@@ -6,8 +6,8 @@ import "golang.org/x/text/language"
 var (
 	// englishLocale defines the standard English locale information for formatting
 	// dates and times. Used as the default locale when no specific locale is requested.
-	englishLocale = &strftimeLocaleInfo{
-		tag:    language.English,
+	englishLocale = &Locale{
+		Tag:    language.English,
 		DTfmt:  "%a %b %e %H:%M:%S %Y", // Example: "Mon Jan  2 22:04:05 2006"
 		Dfmt:   "%m/%d/%y",             // Example: "01/02/06"
 		Tfmt:   "%H:%M:%S",             // Example: "22:04:05"
@@ -25,8 +25,8 @@ var (
 
 	// americanEnglishLocale defines the American English locale information.
 	// The main difference from standard English is the date format, which uses full year (%Y vs %y).
-	americanEnglishLocale = &strftimeLocaleInfo{
-		tag:    language.AmericanEnglish,
+	americanEnglishLocale = &Locale{
+		Tag:    language.AmericanEnglish,
 		DTfmt:  "%a %b %e %H:%M:%S %Y", // Example: "Mon Jan  2 22:04:05 2006"
 		Dfmt:   "%m/%d/%Y",             // Example: "01/02/2006" (note the 4-digit year)
 		Tfmt:   "%H:%M:%S",             // Example: "22:04:05"
@@ -44,14 +44,16 @@ var (
 
 	// britishEnglishLocale defines the British English locale information.
 	// Notable differences include the date/time format and lowercase am/pm indicators.
-	britishEnglishLocale = &strftimeLocaleInfo{
-		tag:    language.BritishEnglish,
+	britishEnglishLocale = &Locale{
+		Tag:    language.BritishEnglish,
 		DTfmt:  "%a %d %b %Y %T %Z",   // Example: "Mon 02 Jan 2006 22:04:05 UTC"
-		Dfmt:   "%m/%d/%y",            // Example: "01/02/06"
+		Dfmt:   "%d/%m/%y",            // Example: "02/01/06"
 		Tfmt:   "%T",                  // Example: "22:04:05" (using %T shorthand)
 		Tfmt12: "%l:%M:%S %P %Z",      // Example: "10:04:05 pm UTC" (note lowercase pm)
 		AmPm:   [2]string{"am", "pm"}, // Lowercase AM/PM indicators
 
+		DfmtShort: "%d/%m/%y", // Example: "02/01/06"
+
 		// Day names (same as standard English)
 		AbDay: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
 		Day:   [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
@@ -60,4 +62,48 @@ var (
 		AbMonth: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
 		Month:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
 	}
+
+	// Other English speaking regions only define what differs from English, the rest
+	// being inherited (see RegisterLocale). Regions without an entry of their own, such
+	// as en-NZ or en-SG, fall back to "en-001" (international English), then to English.
+	internationalEnglishLocale = &Locale{
+		Tag:    language.MustParse("en-001"),
+		DTfmt:  "%a %d %b %Y %T", // Example: "Mon 02 Jan 2006 22:04:05"
+		Dfmt:   "%d/%m/%Y",       // Example: "02/01/2006"
+		Tfmt12: "%l:%M:%S %P",    // Example: "10:04:05 pm"
+		AmPm:   [2]string{"am", "pm"},
+
+		DfmtShort: "%d/%m/%y", // Example: "02/01/06"
+	}
+
+	australianEnglishLocale = &Locale{
+		Tag:  language.MustParse("en-AU"),
+		Dfmt: "%d/%m/%Y", // Example: "02/01/2006"
+	}
+
+	canadianEnglishLocale = &Locale{
+		Tag:    language.MustParse("en-CA"),
+		Dfmt:   "%Y-%m-%d",    // Example: "2006-01-02"
+		Tfmt12: "%l:%M:%S %P", // Example: "10:04:05 p.m."
+		AmPm:   [2]string{"a.m.", "p.m."},
+
+		DfmtShort: "%y-%m-%d", // Example: "06-01-02"
+	}
+
+	irishEnglishLocale = &Locale{
+		Tag:  language.MustParse("en-IE"),
+		AmPm: [2]string{"a.m.", "p.m."},
+	}
+
+	indianEnglishLocale = &Locale{
+		Tag:  language.MustParse("en-IN"),
+		Dfmt: "%d/%m/%y", // Example: "02/01/06"
+	}
+
+	southAfricanEnglishLocale = &Locale{
+		Tag:  language.MustParse("en-ZA"),
+		Dfmt: "%Y/%m/%d", // Example: "2006/01/02"
+
+		DfmtShort: "%Y/%m/%d", // Example: "2006/01/02"
+	}
 )
@@ -0,0 +1,180 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	// persianLocale defines the Persian locale information for formatting dates and
+	// times. Normal formats use the Gregorian calendar, while era formats (%Ec, %Ex and
+	// %EX) use the Solar Hijri calendar and Persian digits, as in ۱۴۰۳/۰۷/۲۵.
+	persianLocale = &Locale{
+		Tag:       language.Persian,
+		DTfmt:     "%A %-d %B %Y، ساعت %T",               // Example: "دوشنبه 2 ژانویه 2006، ساعت 22:04:05"
+		Dfmt:      "%Y/%m/%d",                            // Example: "2006/01/02"
+		Tfmt:      "%T",                                  // Example: "22:04:05"
+		Tfmt12:    "%I:%M:%S %p",                         // Example: "10:04:05 ب.ظ."
+		DfmtShort: "%y/%m/%d",                            // Example: "06/01/02"
+		DTfmtEra:  "%A %-OEd %EB %OEy، ساعت %OH:%OM:%OS", // Example: "دوشنبه ۱۲ دی ۱۳۸۴، ساعت ۲۲:۰۴:۰۵"
+		DfmtEra:   "%OEy/%OEm/%OEd",                      // Example: "۱۳۸۴/۱۰/۱۲"
+		TfmtEra:   "%OH:%OM:%OS",                         // Example: "۲۲:۰۴:۰۵"
+		AmPm:      [2]string{"ق.ظ.", "ب.ظ."},             // AM/PM indicators (قبل‌ازظهر and بعدازظهر)
+		Calendar:  persianCalendar{},                     // Solar Hijri calendar
+		Numbering: "arabext",                             // Persian digits for %O, e.g. "۰۲"
+
+		AbDay:   [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		Day:     [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"},
+		AbMonth: [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+		Month:   [12]string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"},
+	}
+
+	// afghanPersianLocale defines the Dari (Persian of Afghanistan) locale. Gregorian
+	// months have different names, and Solar Hijri months have the names of the signs of
+	// the zodiac (حمل, ثور, ...). The rest is inherited from persianLocale.
+	afghanPersianLocale = &Locale{
+		Tag:     language.MustParse("fa-AF"),
+		AbMonth: [12]string{"جنو", "فبروری", "مارچ", "اپریل", "می", "جون", "جول", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسم"},
+		Month:   [12]string{"جنوری", "فبروری", "مارچ", "اپریل", "می", "جون", "جولای", "اگست", "سپتمبر", "اکتوبر", "نومبر", "دسمبر"},
+	}
+)
+
+// persianMonths holds the Solar Hijri month names in Persian (Iran), Dari (Afghanistan)
+// and English.
+var persianMonths = struct {
+	persian, dari, english [12]string
+}{
+	persian: [12]string{"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"},
+	dari:    [12]string{"حمل", "ثور", "جوزا", "سرطان", "اسد", "سنبله", "میزان", "عقرب", "قوس", "جدی", "دلو", "حوت"},
+	english: [12]string{"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"},
+}
+
+var (
+	// persianBase is the base language of Persian locales
+	persianBase, _ = language.Persian.Base()
+
+	// afghanistan is the region of Dari locales
+	afghanistan = language.MustParseRegion("AF")
+)
+
+// persianCalendar is the Solar Hijri (Jalali) calendar, the official calendar of Iran
+// and Afghanistan. Years start at the March equinox (Nowruz) and are counted from the
+// Hijra. The first six months have 31 days, the next five 30 days, and the last one 29
+// days, or 30 in leap years. Leap years follow the astronomical calendar as computed by
+// Kazimierz Borkowski's algorithm, valid for the years -61 to 3177.
+type persianCalendar struct{}
+
+// persianBreaks are the years at which the 33 years leap cycle of the Jalali calendar
+// is broken.
+var persianBreaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianYear returns the position of Jalali year jy in the 4 years leap cycle (0 for a
+// leap year), the Gregorian year of its start, and the day of March of its first day.
+func persianYear(jy int) (leap, gy, march int) {
+	gy = jy + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if jy < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := jy - jp
+
+	// number of leap years since the start of the cycle, in both calendars
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, gy, march
+}
+
+// persianFromDayNumber returns the Jalali year, month and day of Julian day number jdn.
+func persianFromDayNumber(jdn int) (year, month, day int) {
+	gy, _, _ := dateFromDayNumber(jdn)
+	year = gy - 621
+	if year < persianBreaks[0] || year >= persianBreaks[len(persianBreaks)-1] {
+		return persianArithmetic(jdn)
+	}
+	leap, _, march := persianYear(year)
+	k := jdn - dayNumber(gy, time.March, march)
+	switch {
+	case k >= 0 && k <= 185:
+		return year, 1 + k/31, k%31 + 1
+	case k >= 0:
+		k -= 186
+	default:
+		// the date is in the previous year, which started in the previous Gregorian year
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return year, 7 + k/30, k%30 + 1
+}
+
+// persianArithmetic returns the Jalali year, month and day of Julian day number jdn with
+// the arithmetic 33 years cycle, for dates out of the range of persianYear.
+func persianArithmetic(jdn int) (year, month, day int) {
+	n := jdn - 1948320 // March 19th, 622 (Julian)
+	year = 1 + floorDiv(33*n+3, 12053)
+	days := n - (365*(year-1) + floorDiv(8*year+21, 33))
+	if days < 186 {
+		return year, 1 + days/31, days%31 + 1
+	}
+	days -= 186
+	return year, 7 + days/30, days%30 + 1
+}
+
+// Date returns the Solar Hijri date of t.
+func (persianCalendar) Date(t time.Time) CalendarDate {
+	y, m, d := persianFromDayNumber(dayNumber(t.Date()))
+	return CalendarDate{Time: t, Year: y, Month: m, Day: d}
+}
+
+// AppendEra appends the era name ('C'), the year ('y', 'r' and 'k'), or both ('Y'), in
+// Persian (ه‍.ش.) or English (AP).
+func (persianCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	era := "AP"
+	if base, _ := tag.Base(); base == persianBase {
+		era = "ه‍.ش."
+	}
+
+	switch c {
+	case 'C':
+		return append(b, era...), true // Return just the era name
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true // Return just the year within era
+	case 'Y':
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(append(b, ' '), era...), true
+	}
+	return b, false
+}
+
+// MonthName returns the name of the Solar Hijri month in Persian, Dari or English.
+func (persianCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	if base, _ := tag.Base(); base == persianBase {
+		if region, _ := tag.Region(); region == afghanistan {
+			return persianMonths.dari[d.Month-1]
+		}
+		return persianMonths.persian[d.Month-1]
+	}
+	return persianMonths.english[d.Month-1]
+}
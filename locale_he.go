@@ -0,0 +1,222 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// hebrewLocale defines the Hebrew locale information for formatting dates and times.
+// Era formats (%Ec and %Ex) use the Hebrew calendar with Hebrew numerals, as in
+// כ״ה בתשרי תשפ״ה, while normal formats use the Gregorian calendar.
+var hebrewLocale = &Locale{
+	Tag:       language.Hebrew,
+	DTfmt:     "%a %d %b %Y %T",             // Example: "יום ב׳ 02 ינו׳ 2006 22:04:05"
+	Dfmt:      "%d.%m.%Y",                   // Example: "02.01.2006"
+	Tfmt:      "%T",                         // Example: "22:04:05"
+	Tfmt12:    "%I:%M:%S %p",                // Example: "10:04:05 אחה״צ"
+	DfmtShort: "%d.%m.%y",                   // Example: "02.01.06"
+	DTfmtEra:  "%A %OEd ב%EB %OEy %T",       // Example: "יום שני ב׳ בטבת תשס״ו 22:04:05"
+	DfmtEra:   "%OEd ב%EB %OEy",             // Example: "ב׳ בטבת תשס״ו"
+	AmPm:      [2]string{"לפנה״צ", "אחה״צ"}, // AM/PM indicators
+	Calendar:  hebrewCalendar{},             // Hebrew calendar
+	Numbering: "hebr",                       // Hebrew numerals for %O, e.g. "כ״ה"
+
+	AbDay:   [7]string{"יום א׳", "יום ב׳", "יום ג׳", "יום ד׳", "יום ה׳", "יום ו׳", "שבת"},
+	Day:     [7]string{"יום ראשון", "יום שני", "יום שלישי", "יום רביעי", "יום חמישי", "יום שישי", "יום שבת"},
+	AbMonth: [12]string{"ינו׳", "פבר׳", "מרץ", "אפר׳", "מאי", "יוני", "יולי", "אוג׳", "ספט׳", "אוק׳", "נוב׳", "דצמ׳"},
+	Month:   [12]string{"ינואר", "פברואר", "מרץ", "אפריל", "מאי", "יוני", "יולי", "אוגוסט", "ספטמבר", "אוקטובר", "נובמבר", "דצמבר"},
+}
+
+// hebrewMonths holds the Hebrew calendar month names in Hebrew and English, from Tishri
+// to Elul with both Adar I and Adar II. In common years, the month between Shevat and
+// Nisan is called Adar.
+var hebrewMonths = struct {
+	hebrew, english [13]string
+	adar            [2]string // Adar of common years, in Hebrew and English
+}{
+	hebrew:  [13]string{"תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר א׳", "אדר ב׳", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול"},
+	english: [13]string{"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar II", "Nisan", "Iyar", "Sivan", "Tamuz", "Av", "Elul"},
+	adar:    [2]string{"אדר", "Adar"},
+}
+
+// hebrewBase is the base language of Hebrew locales
+var hebrewBase, _ = language.Hebrew.Base()
+
+// hebrewCalendar is the Hebrew calendar, a lunisolar calendar in which years start with
+// Tishri, in autumn. Leap years (7 in every 19 years cycle) have an additional month,
+// Adar I, before Adar (then called Adar II). New years are computed from the mean new
+// moon (molad) of Tishri, and postponed so that Yom Kippur is not next to a Sabbath and
+// that years keep a valid length; Heshvan and Kislev have 29 or 30 days depending on the
+// length of the year. Months are numbered from Tishri, so Nisan is month 7 in common
+// years and month 8 in leap years. %Ey gives the year without thousands, as commonly
+// written with Hebrew numerals (תשפ״ה for 5785).
+type hebrewCalendar struct{}
+
+// hebrewLeapYear reports whether Hebrew year y has 13 months.
+func hebrewLeapYear(y int) bool {
+	return (7*y+1)%19 < 7
+}
+
+// hebrewElapsedDays returns the number of days between the epoch of the calendar and the
+// molad of Tishri of year y, moved to the next day when it falls on a Sunday, Wednesday
+// or Friday.
+func hebrewElapsedDays(y int) int {
+	months := floorDiv(235*y-234, 19)
+	parts := 12084 + 13753*int64(months) // in 1/25920 days (halakim)
+	day := 29*months + int(parts/25920)
+	if (3*(day+1))%7 < 3 {
+		day++
+	}
+	return day
+}
+
+// hebrewNewYear returns the Julian day number of the first day of Hebrew year y.
+func hebrewNewYear(y int) int {
+	d := hebrewElapsedDays(y)
+	switch {
+	case hebrewElapsedDays(y+1)-d == 356:
+		// the year would be too long
+		d += 2
+	case d-hebrewElapsedDays(y-1) == 382:
+		// the previous year would be too short
+		d++
+	}
+	return 347998 + d // October 7th, 3761 BC (Julian)
+}
+
+// Date returns the Hebrew date of t, with LeapMonth set for Adar I.
+func (hebrewCalendar) Date(t time.Time) CalendarDate {
+	jdn := dayNumber(t.Date())
+	y := int(float64(jdn-347998)/365.2468) + 1
+	for hebrewNewYear(y+1) <= jdn {
+		y++
+	}
+	for hebrewNewYear(y) > jdn {
+		y--
+	}
+
+	start := hebrewNewYear(y)
+	length := hebrewNewYear(y+1) - start
+	months := []int{30, 29, 30, 29, 30, 30, 29, 30, 29, 30, 29, 30, 29}
+	if length%10 == 5 {
+		months[1] = 30 // Heshvan of complete years
+	}
+	if length%10 == 3 {
+		months[2] = 29 // Kislev of deficient years
+	}
+	if !hebrewLeapYear(y) {
+		months = append(months[:5], months[6:]...)
+	}
+
+	day := jdn - start
+	m := 0
+	for day >= months[m] {
+		day -= months[m]
+		m++
+	}
+	return CalendarDate{Time: t, Year: y, Month: m + 1, Day: day + 1, LeapMonth: m == 5 && len(months) == 13}
+}
+
+// AppendEra appends the era name ('C'), the year without thousands ('y'), the full year
+// ('r' and 'k'), or the full year and era name ('Y').
+func (hebrewCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	era := "AM"
+	if base, _ := tag.Base(); base == hebrewBase {
+		era = "לבריאת העולם"
+	}
+
+	switch c {
+	case 'C':
+		return append(b, era...), true // Return just the era name
+	case 'y':
+		return strconv.AppendInt(b, int64(d.Year%1000), 10), true
+	case 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true
+	case 'Y':
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(append(b, ' '), era...), true
+	}
+	return b, false
+}
+
+// MonthName returns the name of the Hebrew month in Hebrew or English.
+func (hebrewCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	names, lang := &hebrewMonths.english, 1
+	if base, _ := tag.Base(); base == hebrewBase {
+		names, lang = &hebrewMonths.hebrew, 0
+	}
+	switch {
+	case hebrewLeapYear(d.Year) || d.Month < 6:
+		return names[d.Month-1]
+	case d.Month == 6:
+		return hebrewMonths.adar[lang]
+	}
+	return names[d.Month]
+}
+
+// Hebrew letters used as numerals for units, tens and hundreds
+var (
+	heUnits    = [10]string{"", "א", "ב", "ג", "ד", "ה", "ו", "ז", "ח", "ט"}
+	heTens     = [10]string{"", "י", "כ", "ל", "מ", "נ", "ס", "ע", "פ", "צ"}
+	heHundreds = [5]string{"", "ק", "ר", "ש", "ת"}
+)
+
+// strftimeHebrewNumeral converts a numeric value into Hebrew numerals (gematria), as used
+// for dates of the Hebrew calendar (hebr numbering system). For example, 25 becomes "כ״ה"
+// and 5785 becomes "ה׳תשפ״ה". 15 and 16 are written ט״ו and ט״ז rather than with the
+// letters of the divine name.
+//
+// Parameters:
+//   - b: Byte slice to append the formatted result to
+//   - v: Integer value to convert to Hebrew numerals
+//
+// Returns: The byte slice with Hebrew numerals appended
+func strftimeHebrewNumeral(b []byte, v int) []byte {
+	if v <= 0 {
+		// there is no Hebrew numeral for zero or negative values
+		return strconv.AppendInt(b, int64(v), 10)
+	}
+	if v >= 1000 {
+		// thousands are written as a single number followed by a geresh
+		b = strftimeHebrewNumeral(b, v/1000)
+		if !bytes.HasSuffix(b, []byte("׳")) {
+			b = append(b, "׳"...)
+		}
+		if v %= 1000; v == 0 {
+			return b
+		}
+	}
+
+	var letters []string
+	for ; v >= 400; v -= 400 {
+		letters = append(letters, heHundreds[4])
+	}
+	if v >= 100 {
+		letters = append(letters, heHundreds[v/100])
+		v %= 100
+	}
+	switch v {
+	case 15, 16:
+		letters = append(letters, heUnits[9], heUnits[v-9])
+	default:
+		if v >= 10 {
+			letters = append(letters, heTens[v/10])
+		}
+		if v%10 != 0 {
+			letters = append(letters, heUnits[v%10])
+		}
+	}
+
+	// a geresh follows a single letter, and a gershayim precedes the last one
+	if len(letters) == 1 {
+		return append(append(b, letters[0]...), "׳"...)
+	}
+	for _, l := range letters[:len(letters)-1] {
+		b = append(b, l...)
+	}
+	return append(append(b, "״"...), letters[len(letters)-1]...)
+}
@@ -2,26 +2,31 @@
 package strftime
 
 import (
-	"fmt"
+	"errors"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/text/language"
 )
 
 // japaneseLocale defines the Japanese locale information for formatting dates and times.
 // It includes specialized formatting for Japanese era years and Japanese numerals.
-var japaneseLocale = &strftimeLocaleInfo{
-	tag:      language.Japanese,
-	DTfmt:    "%Y年%m月%d日 %H時%M分%S秒",   // Date and time format
-	Dfmt:     "%Y年%m月%d日",             // Date format
-	Tfmt:     "%H時%M分%S秒",             // Time format
-	Tfmt12:   "%p%I時%M分%S秒",           // 12-hour time format
-	DTfmtEra: "%EY%m月%d日 %H時%M分%S秒",   // Date and time format with era
-	DfmtEra:  "%EY%m月%d日",             // Date format with era
-	AmPm:     [...]string{"午前", "午後"}, // AM/PM indicators
-	Eyear:    strftimeJapaneseEra,     // Era year formatting function
-	Oprint:   strftimeJapaneseDigit,   // Japanese numeral formatting function
+var japaneseLocale = &Locale{
+	Tag:       language.Japanese,
+	DTfmt:     "%Y年%m月%d日 %H時%M分%S秒",   // Date and time format
+	Dfmt:      "%Y年%m月%d日",             // Date format
+	Tfmt:      "%H時%M分%S秒",             // Time format
+	Tfmt12:    "%p%I時%M分%S秒",           // 12-hour time format
+	DfmtShort: "%y/%m/%d",              // Short date format, e.g. 06/01/02
+	TfmtShort: "%H時%M分",                // Hours and minutes format
+	DTfmtEra:  "%EY%m月%d日 %H時%M分%S秒",   // Date and time format with era
+	DfmtEra:   "%EY%m月%d日",             // Date format with era
+	AmPm:      [...]string{"午前", "午後"}, // AM/PM indicators
+	Calendar:  japaneseCalendar{},      // Japanese era calendar
+	Numbering: "jpan",                  // Japanese numerals for %O
 
 	// Day names in Japanese
 	AbDay: [...]string{"日", "月", "火", "水", "木", "金", "土"},
@@ -32,56 +37,150 @@ var japaneseLocale = &strftimeLocaleInfo{
 	Month:   [...]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
 }
 
-// strftimeJapaneseEra formats years according to the Japanese era calendar system.
-// The Japanese calendar uses era names (Reiwa, Heisei, Showa, etc.) and years are counted
-// from the beginning of each era.
+// japaneseEra describes an era of the Japanese calendar.
+type japaneseEra struct {
+	name   string // Era name, e.g. 令和
+	abbrev string // Romanized abbreviation, e.g. R
+	short  string // Single-kanji abbreviation, e.g. 令
+	start  int    // First day of the era, as yyyymmdd
+}
+
+// japaneseBuiltinEras lists the built-in eras of the Japanese calendar, most recent
+// first
+var japaneseBuiltinEras = [...]japaneseEra{
+	{"令和", "R", "令", 20190501}, // Reiwa era - from May 1, 2019
+	{"平成", "H", "平", 19890108}, // Heisei era - from January 8, 1989 to April 30, 2019
+	{"昭和", "S", "昭", 19261225}, // Showa era - from December 25, 1926 to January 7, 1989
+	{"大正", "T", "大", 19120730}, // Taisho era - from July 30, 1912 to December 24, 1926
+	{"明治", "M", "明", 18681023}, // Meiji era - from October 23, 1868 to July 29, 1912
+}
+
+var (
+	// japaneseErasLock protects japaneseEras, which can be extended at runtime
+	japaneseErasLock sync.RWMutex
+
+	// japaneseEras lists the eras of the Japanese calendar, most recent first
+	japaneseEras = append([]japaneseEra(nil), japaneseBuiltinEras[:]...)
+)
+
+// RegisterJapaneseEra adds an era to the Japanese calendar, so that a newly proclaimed
+// era can be used without waiting for a new release of this package. The era applies
+// to dates starting on the year, month and day of start, until the start of the next
+// era, if any. Registering an era with the same start date as an existing era replaces it.
 //
 // Parameters:
-//   - t: Time value to format
-//   - r: Format request byte ('C' for era name, 'y' for year within era, 'Y' for full era + year)
+//   - name: Era name in kanji (e.g. 令和); its first character is used as single-kanji abbreviation
+//   - abbrev: Romanized abbreviation of the era (e.g. R)
+//   - start: First day of the era
 //
-// Returns: Formatted string representation of the year according to Japanese era system
-func strftimeJapaneseEra(t time.Time, r byte) string {
-	era := "西暦" // Default to Western calendar (Seireki)
-	y, m, d := t.Date()
-
-	switch {
-	case (y > 2019) || ((y == 2019) && (m > 5)) || ((y == 2019) && (m == 5) && (d >= 1)):
-		// Reiwa era (令和) - from May 1, 2019
-		era = "令和"
-		y -= 2018
-	case (y > 1989) || ((y == 1989) && (m > 1)) || ((y == 1989) && (m == 1) && (d >= 8)):
-		// Heisei era (平成) - from January 8, 1989 to April 30, 2019
-		era = "平成"
-		y -= 1988
-	case (y > 1926) || ((y == 1926) && (m > 12)) || ((y == 1926) && (m == 12) && (d >= 25)):
-		// Showa era (昭和) - from December 25, 1926 to January 7, 1989
-		era = "昭和"
-		y -= 1925
-	case (y > 1912) || ((y == 1912) && (m > 7)) || ((y == 1912) && (m == 7) && (d >= 30)):
-		// Taisho era (大正) - from July 30, 1912 to December 24, 1926
-		era = "大正"
-		y -= 1911
-	case (y > 1868) || ((y == 1868) && (m > 10)) || ((y == 1868) && (m == 10) && (d >= 23)):
-		// Meiji era (明治) - from October 23, 1868 to July 29, 1912
-		era = "明治"
-		y -= 1867
+// Returns: Error if the era name is empty
+func RegisterJapaneseEra(name, abbrev string, start time.Time) error {
+	if name == "" {
+		return errors.New("strftime: RegisterJapaneseEra called with empty era name")
 	}
+	_, size := utf8.DecodeRuneInString(name)
+	y, m, d := start.Date()
+	era := japaneseEra{name: name, abbrev: abbrev, short: name[:size], start: y*10000 + int(m)*100 + d}
+
+	japaneseErasLock.Lock()
+	defer japaneseErasLock.Unlock()
 
-	switch r {
+	i := sort.Search(len(japaneseEras), func(i int) bool { return japaneseEras[i].start <= era.start })
+	if i < len(japaneseEras) && japaneseEras[i].start == era.start {
+		japaneseEras[i] = era
+		return nil
+	}
+	eras := make([]japaneseEra, 0, len(japaneseEras)+1)
+	eras = append(eras, japaneseEras[:i]...)
+	eras = append(eras, era)
+	japaneseEras = append(eras, japaneseEras[i:]...)
+	return nil
+}
+
+// japaneseCalendar is the Japanese calendar, in which years are counted from the
+// beginning of each era (Reiwa, Heisei, Showa, etc.), while months and days are the
+// Gregorian ones. Dates before the Meiji era use the Western calendar (西暦).
+type japaneseCalendar struct{}
+
+// Date returns the date of t, with Era set to the first day of its era as yyyymmdd (0
+// before the Meiji era).
+func (japaneseCalendar) Date(t time.Time) CalendarDate {
+	d := gregorianDate(t)
+	date := d.Year*10000 + d.Month*100 + d.Day
+
+	japaneseErasLock.RLock()
+	defer japaneseErasLock.RUnlock()
+	for _, e := range japaneseEras {
+		if date >= e.start {
+			d.Era = e.start
+			d.Year -= e.start/10000 - 1
+			break
+		}
+	}
+	return d
+}
+
+// AppendEra appends the era name ('C'), the year within the era ('y'), both ('Y'), or
+// the year preceded by the romanized ('r', as in R6) or single-kanji ('k', as in 令6)
+// era abbreviation.
+func (japaneseCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	era := japaneseEra{name: "西暦"} // Default to Western calendar (Seireki)
+	if d.Era != 0 {
+		japaneseErasLock.RLock()
+		for _, e := range japaneseEras {
+			if e.start == d.Era {
+				era = e
+				break
+			}
+		}
+		japaneseErasLock.RUnlock()
+	}
+
+	switch c {
 	case 'C':
-		return era // Return just the era name
+		return append(b, era.name...), true // Return just the era name
 	case 'y':
-		return strconv.FormatInt(int64(y), 10) // Return just the year within era
+		return strconv.AppendInt(b, int64(d.Year), 10), true // Return just the year within era
 	case 'Y':
-		if y == 1 {
+		b = append(b, era.name...)
+		if d.Year == 1 && era.start != 0 {
 			// First year of a given era is called "Gannen" (元年)
-			return era + "元年"
-		} else {
-			return fmt.Sprintf("%s%d年", era, y)
+			return append(b, "元年"...), true
 		}
+		b = strconv.AppendInt(b, int64(d.Year), 10)
+		return append(b, "年"...), true
+	case 'r':
+		return strconv.AppendInt(append(b, era.abbrev...), int64(d.Year), 10), true
+	case 'k':
+		return strconv.AppendInt(append(b, era.short...), int64(d.Year), 10), true
+	}
+	return b, false
+}
+
+// gregorianYear returns the Gregorian year of year y of the era starting on era (as
+// yyyymmdd), or y itself for the Western calendar (era 0).
+func (japaneseCalendar) gregorianYear(era, y int) int {
+	if era == 0 {
+		return y
 	}
-	return fmt.Sprintf("%%E%c", r) // Return unhandled format
+	return era/10000 + y - 1
+}
+
+// eras returns the first days of the eras of the Japanese calendar, and 0 for the
+// Western calendar.
+func (japaneseCalendar) eras() []int {
+	japaneseErasLock.RLock()
+	defer japaneseErasLock.RUnlock()
+	res := make([]int, 0, len(japaneseEras)+1)
+	for _, e := range japaneseEras {
+		res = append(res, e.start)
+	}
+	return append(res, 0)
+}
+
+// MonthName returns an empty string, as months are the Gregorian ones.
+func (japaneseCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return ""
 }
 
 // Japanese numeral characters for digits 0-9
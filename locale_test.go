@@ -0,0 +1,17 @@
+package strftime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLocalesComplete checks that all built-in locales are complete
+func TestLocalesComplete(t *testing.T) {
+	strftimeLocaleLock.RLock()
+	defer strftimeLocaleLock.RUnlock()
+
+	for _, l := range strftimeLocales {
+		assert.NoError(t, checkLocale(l), `checking locale `+l.Tag.String())
+	}
+}
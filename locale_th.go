@@ -0,0 +1,85 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// thaiLocale defines the Thai locale information for formatting dates and times.
+// Thai dates use the Buddhist Era (พุทธศักราช) for years, which is the legally
+// required calendar for official documents in Thailand.
+var thaiLocale = &Locale{
+	Tag:       language.Thai,
+	DTfmt:     "%a %e %b %Ey, %H:%M:%S",              // Example: "จ.  2 ม.ค. 2549, 22:04:05"
+	Dfmt:      "%d/%m/%Ey",                           // Example: "02/01/2549"
+	Tfmt:      "%H:%M:%S",                            // Example: "22:04:05"
+	Tfmt12:    "%I:%M:%S %p",                         // Example: "10:04:05 PM"
+	DfmtShort: "%d/%m/%Eg",                           // Example: "02/01/49"
+	DTfmtEra:  "วัน%Aที่ %e %B %EC %Ey, %H.%M.%S น.", // Example: "วันจันทร์ที่  2 มกราคม พ.ศ. 2549, 22.04.05 น."
+	DfmtEra:   "%e %b %Ey",                           // Example: " 2 ม.ค. 2549"
+	TfmtEra:   "%H.%M.%S น.",                         // Example: "22.04.05 น."
+	AmPm:      [2]string{"AM", "PM"},                 // AM/PM indicators
+	Calendar:  buddhistCalendar{},                    // Buddhist Era calendar
+	Numbering: "thai",                                // Thai digits for %O, e.g. "๐๒"
+
+	// Day names in Thai
+	AbDay: [7]string{"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."},
+	Day:   [7]string{"อาทิตย์", "จันทร์", "อังคาร", "พุธ", "พฤหัสบดี", "ศุกร์", "เสาร์"},
+
+	// Month names in Thai
+	AbMonth: [12]string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."},
+	Month:   [12]string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"},
+}
+
+// buddhistCalendar is the Thai Buddhist Era calendar (พ.ศ.), which counts years from
+// 543 BC. Since 1941, the Thai year starts on January 1st like the Gregorian year, so
+// the Buddhist Era year is always the Gregorian year plus 543.
+type buddhistCalendar struct{}
+
+// Date returns the date of t, with the Buddhist Era year.
+func (buddhistCalendar) Date(t time.Time) CalendarDate {
+	d := gregorianDate(t)
+	d.Year += 543
+	return d
+}
+
+// AppendEra appends the era name ('C'), the year ('y', 'r' and 'k'), or both ('Y'), in
+// Thai or English.
+func (buddhistCalendar) AppendEra(b []byte, tag language.Tag, d CalendarDate, c byte) ([]byte, bool) {
+	base, _ := tag.Base()
+	switch c {
+	case 'C':
+		if base != thaiBase {
+			return append(b, "BE"...), true
+		}
+		return append(b, "พ.ศ."...), true // Return just the era name
+	case 'y', 'r', 'k':
+		return strconv.AppendInt(b, int64(d.Year), 10), true // Return just the year within era
+	case 'Y':
+		if base != thaiBase {
+			return append(strconv.AppendInt(b, int64(d.Year), 10), " BE"...), true
+		}
+		return strconv.AppendInt(append(b, "พ.ศ. "...), int64(d.Year), 10), true
+	}
+	return b, false
+}
+
+var thaiBase, _ = language.Thai.Base()
+
+// gregorianYear returns the Gregorian year of Buddhist Era year y.
+func (buddhistCalendar) gregorianYear(era, y int) int {
+	return y - 543
+}
+
+// eras returns the only era of the Buddhist calendar.
+func (buddhistCalendar) eras() []int {
+	return []int{0}
+}
+
+// MonthName returns an empty string, as months are the Gregorian ones.
+func (buddhistCalendar) MonthName(tag language.Tag, d CalendarDate, abbrev bool) string {
+	return ""
+}
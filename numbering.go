@@ -0,0 +1,180 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"errors"
+	"sync"
+)
+
+// NumberingSystem describes how numbers are written by %O conversions (such as %Od or
+// %OY). Decimal systems only replace the ASCII digits 0 to 9, so that numbers keep their
+// usual padding, while algorithmic systems (such as Japanese numerals, where 22 is
+// written 二十二) format the whole number.
+type NumberingSystem struct {
+	Name   string                   // CLDR identifier of the numbering system, such as "arab"
+	Digits [10]string               // Digits from 0 to 9, for decimal systems
+	Format func([]byte, int) []byte // Appends a number, for algorithmic systems (Digits is not used when set)
+
+	// DecimalYears makes algorithmic systems write years (%OY, %Oy, %OG and %Og) digit by
+	// digit with Digits, as in Chinese 二〇〇六年 where other numbers are positional (十二月)
+	DecimalYears bool
+}
+
+var (
+	// strftimeNumberingLock protects strftimeNumberingSystems, which can be updated at
+	// runtime by RegisterNumberingSystem
+	strftimeNumberingLock sync.RWMutex
+
+	// strftimeNumberingSystems maps CLDR numbering system identifiers to their definition
+	strftimeNumberingSystems = builtinNumberingSystems()
+)
+
+// strftimeDecimalDigits lists the built-in CLDR decimal numbering systems with their
+// digits from 0 to 9.
+var strftimeDecimalDigits = map[string]string{
+	"latn":     "0123456789",
+	"arab":     "٠١٢٣٤٥٦٧٨٩",
+	"arabext":  "۰۱۲۳۴۵۶۷۸۹",
+	"beng":     "০১২৩৪৫৬৭৮৯",
+	"deva":     "०१२३४५६७८९",
+	"fullwide": "０１２３４５６７８９",
+	"gujr":     "૦૧૨૩૪૫૬૭૮૯",
+	"guru":     "੦੧੨੩੪੫੬੭੮੯",
+	"hanidec":  "〇一二三四五六七八九",
+	"khmr":     "០១២៣៤៥៦៧៨៩",
+	"knda":     "೦೧೨೩೪೫೬೭೮೯",
+	"laoo":     "໐໑໒໓໔໕໖໗໘໙",
+	"mlym":     "൦൧൨൩൪൫൬൭൮൯",
+	"mymr":     "၀၁၂၃၄၅၆၇၈၉",
+	"orya":     "୦୧୨୩୪୫୬୭୮୯",
+	"tamldec":  "௦௧௨௩௪௫௬௭௮௯",
+	"telu":     "౦౧౨౩౪౫౬౭౮౯",
+	"thai":     "๐๑๒๓๔๕๖๗๘๙",
+	"tibt":     "༠༡༢༣༤༥༦༧༨༩",
+}
+
+// builtinNumberingSystems returns the numbering systems available without registration:
+// the decimal systems of strftimeDecimalDigits, Japanese numerals (jpan), Chinese
+// numerals (hans and hant) and Hebrew numerals (hebr).
+func builtinNumberingSystems() map[string]*NumberingSystem {
+	res := map[string]*NumberingSystem{
+		"jpan": {Name: "jpan", Format: strftimeJapaneseDigit},
+		"hans": {Name: "hans", Format: strftimeSimplifiedChineseDigit, Digits: zhDigits, DecimalYears: true},
+		"hant": {Name: "hant", Format: strftimeTraditionalChineseDigit, Digits: zhDigits, DecimalYears: true},
+		"hebr": {Name: "hebr", Format: strftimeHebrewNumeral},
+	}
+	for name, d := range strftimeDecimalDigits {
+		ns := &NumberingSystem{Name: name}
+		for i, r := range []rune(d) {
+			ns.Digits[i] = string(r)
+		}
+		res[name] = ns
+	}
+	return res
+}
+
+// RegisterNumberingSystem makes numbering system ns available to locales (through
+// Locale.Numbering), to the nu Unicode extension and to Formatter.WithNumberingSystem.
+// A numbering system already registered with the same name is replaced, however
+// Formatters and locales created before the call keep using the previous one.
+//
+// Parameters:
+//   - ns: Numbering system, with either all its digits or a Format function
+//
+// Returns: Error if the numbering system has no name, or neither digits nor Format
+func RegisterNumberingSystem(ns *NumberingSystem) error {
+	if ns == nil || ns.Name == "" {
+		return errors.New("strftime: RegisterNumberingSystem called without a name")
+	}
+	if ns.Format == nil {
+		for _, d := range ns.Digits {
+			if d == "" {
+				return errors.New("strftime: numbering system " + ns.Name + " has neither digits nor Format")
+			}
+		}
+	}
+	n := *ns
+
+	strftimeNumberingLock.Lock()
+	defer strftimeNumberingLock.Unlock()
+	strftimeNumberingSystems[n.Name] = &n
+	return nil
+}
+
+// LookupNumberingSystem returns a copy of the numbering system registered under the
+// given CLDR identifier, or nil if there is none.
+//
+// Parameters:
+//   - name: CLDR identifier of the numbering system, such as "arab", "thai" or "jpan"
+//
+// Returns: A copy of the numbering system, or nil
+func LookupNumberingSystem(name string) *NumberingSystem {
+	ns := lookupNumberingSystem(name)
+	if ns == nil {
+		return nil
+	}
+	res := *ns
+	return &res
+}
+
+// lookupNumberingSystem returns the numbering system registered under name, or nil.
+func lookupNumberingSystem(name string) *NumberingSystem {
+	strftimeNumberingLock.RLock()
+	defer strftimeNumberingLock.RUnlock()
+	return strftimeNumberingSystems[name]
+}
+
+// appendAltNumber appends v for the %Oc conversion, written with the numbering system
+// of fm. Decimal numbers are padded to width with pad (0 for no padding).
+func appendAltNumber(fm *Formatter, b []byte, c byte, v int64, width int, pad byte) []byte {
+	ns := fm.numbers
+	if ns == nil && fm.l.Oprint != nil {
+		return fm.l.Oprint(b, int(v))
+	}
+	if ns != nil && ns.Format != nil && !(ns.DecimalYears && isYearConversion(c)) {
+		return ns.Format(b, int(v))
+	}
+	start := len(b)
+	b = appendIntPad(b, v, width, pad)
+	if ns != nil && ns.Name != "latn" {
+		b = localDigits(b, start, &ns.Digits)
+	}
+	return b
+}
+
+// altDigitRuns rewrites each number appended to b after offset start with the numbering
+// system of fm, for %OEc conversions whose output mixes numbers and text (such as
+// an era year).
+func altDigitRuns(fm *Formatter, b []byte, start int, c byte) []byte {
+	s := append([]byte(nil), b[start:]...)
+	b = b[:start]
+	for i := 0; i < len(s); {
+		if s[i] < '0' || s[i] > '9' {
+			b = append(b, s[i])
+			i++
+			continue
+		}
+		j, v := i, int64(0)
+		for ; j < len(s) && s[j] >= '0' && s[j] <= '9'; j++ {
+			v = v*10 + int64(s[j]-'0')
+		}
+		b = appendAltNumber(fm, b, c, v, j-i, '0')
+		i = j
+	}
+	return b
+}
+
+// hasAlgorithmicDigits reports whether the %Oc conversion of fm does not write numbers
+// with decimal digits, in which case it cannot be padded nor parsed.
+func hasAlgorithmicDigits(fm *Formatter, c byte) bool {
+	ns := fm.numbers
+	if ns == nil && fm.l.Oprint != nil {
+		return true
+	}
+	return ns != nil && ns.Format != nil && !(ns.DecimalYears && isYearConversion(c))
+}
+
+// isYearConversion reports whether conversion c outputs a year.
+func isYearConversion(c byte) bool {
+	return c == 'Y' || c == 'y' || c == 'G' || c == 'g'
+}
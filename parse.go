@@ -0,0 +1,854 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// ParseError describes a problem parsing a time string.
+type ParseError struct {
+	Pattern string // Pattern the value was parsed against
+	Value   string // Value being parsed
+	Offset  int    // Byte offset in Value where the problem was found
+	Message string // Description of the problem
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	return "strftime: parsing " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Pattern) + ": " + e.Message + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// strftimeParser holds the state of a single strptime-style parse operation.
+type strftimeParser struct {
+	fm      *Formatter
+	l       *Locale // locale of fm
+	pattern string  // top-level pattern, for error reporting
+	value   string  // full input, for error reporting
+	s       string  // remaining input
+
+	year, month, day, yday  int
+	hour, min, sec, nsec    int
+	century, yy             int
+	pm                      int // -1 if unknown, 0 for AM, 1 for PM
+	hasYear, hasCentury     bool
+	hasYY, hasMonth, hasDay bool
+	hasYday, hasEpoch       bool
+	era, eraYear            int // era and year within era of the locale's calendar
+	hasEraYear              bool
+	epoch                   int64
+	width                   int // field width of the numeric conversion being parsed, 0 if none
+
+	zoneName      string
+	zoneOffset    int
+	hasZoneOffset bool
+}
+
+// Parse parses a time string formatted with pattern f in the given locale.
+// In the absence of time zone information, Parse returns a time in UTC.
+//
+// Parameters:
+//   - l: Language tag to determine the locale for parsing
+//   - f: Format string with strftime-compatible format specifiers
+//   - s: Time string to parse
+//
+// Returns: The parsed time value, or a *ParseError if s does not match f
+func Parse(l language.Tag, f, s string) (time.Time, error) {
+	if hasExtensions(l) {
+		return New(l).Parse(f, s)
+	}
+	return parseStrftime(newFormatter(lookupLocale(l)), f, s, time.UTC)
+}
+
+// Parse parses a time string formatted with pattern f, using the locale associated
+// with this Formatter for names of days, months and AM/PM indicators.
+// In the absence of time zone information, Parse returns a time in UTC, or in the
+// time zone given by the tz extension of the Formatter's language tag.
+//
+// Parse understands the same conversion specifiers as Format. Fields that are not
+// present in the pattern default to their zero value (January 1st of year 0 at
+// midnight), week numbers and weekday names are checked for syntax but otherwise
+// ignored. Whitespace in the pattern matches zero or more whitespace characters in
+// the input. The field width of a numeric conversion, as in %3N, is the maximum number
+// of digits read, padding included.
+//
+// Era years (%EY, %EC and %Ey) are parsed in the Buddhist, Minguo and Japanese
+// calendars, whose years are Gregorian years counted from another epoch; other
+// calendar dates cannot be parsed.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//   - s: Time string to parse
+//
+// Returns: The parsed time value, or a *ParseError if s does not match f
+func (obj *Formatter) Parse(f, s string) (time.Time, error) {
+	if obj.loc != nil {
+		// time zone set with the tz extension
+		return parseStrftime(obj, f, s, obj.loc)
+	}
+	return parseStrftime(obj, f, s, time.UTC)
+}
+
+// ParseInLocation is like Parse but interprets times without time zone information
+// in the given location, and uses that location to resolve zone abbreviations (%Z).
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//   - s: Time string to parse
+//   - loc: Location to use in the absence of time zone information
+//
+// Returns: The parsed time value, or a *ParseError if s does not match f
+func (obj *Formatter) ParseInLocation(f, s string, loc *time.Location) (time.Time, error) {
+	return parseStrftime(obj, f, s, loc)
+}
+
+// parseStrftime parses s according to format f and the settings of fm, and builds the
+// resulting time.
+func parseStrftime(fm *Formatter, f, s string, loc *time.Location) (time.Time, error) {
+	p := &strftimeParser{
+		fm:      fm,
+		l:       fm.l,
+		pattern: f,
+		value:   s,
+		s:       s,
+		month:   1,
+		day:     1,
+		pm:      -1,
+	}
+
+	if err := p.run(f); err != nil {
+		return time.Time{}, err
+	}
+	if len(p.s) > 0 {
+		return time.Time{}, p.fail("extra text")
+	}
+	return p.time(loc)
+}
+
+// fail returns a ParseError positioned at the current input offset.
+func (p *strftimeParser) fail(msg string) error {
+	return &ParseError{
+		Pattern: p.pattern,
+		Value:   p.value,
+		Offset:  len(p.value) - len(p.s),
+		Message: msg,
+	}
+}
+
+// run matches the remaining input against format f, recording the values found.
+func (p *strftimeParser) run(f string) error {
+	for len(f) > 0 {
+		c := f[0]
+		if isSpace(c) {
+			// whitespace in the pattern matches any amount of whitespace
+			p.skipSpace()
+			f = f[1:]
+			continue
+		}
+		if c != '%' || len(f) < 2 {
+			if len(p.s) == 0 || p.s[0] != c {
+				return p.fail("expected " + strconv.Quote(string(c)))
+			}
+			p.s = p.s[1:]
+			f = f[1:]
+			continue
+		}
+
+		skip, err := p.directive(f)
+		if err != nil {
+			return err
+		}
+		if skip == 0 {
+			// unknown directive, match the % sign literally like Format outputs it
+			if len(p.s) == 0 || p.s[0] != '%' {
+				return p.fail("expected \"%\"")
+			}
+			p.s = p.s[1:]
+			f = f[1:]
+			continue
+		}
+		f = f[skip:]
+	}
+	return nil
+}
+
+// directive parses the conversion specification at the start of f (which always starts
+// with a % sign) and returns the number of bytes of f it consumed, or 0 if the
+// specification is not recognized.
+func (p *strftimeParser) directive(f string) (int, error) {
+	l := p.l
+	var err error
+
+	switch f[1] {
+	case 'E':
+		if len(f) < 3 {
+			return 0, nil
+		}
+		switch f[2] {
+		case 'c', 'x', 'X': // composite formats
+			return 3, p.run(compositeFormat(l, 'E', f[2]))
+		case 'C', 'y', 'Y':
+			if c := localeCalendar(l); c != nil {
+				return 3, p.eraConversion(c, f[2])
+			}
+			_, err = p.directive("%" + f[2:])
+			return 3, err
+		case 'r', 'k':
+			if c := localeCalendar(l); c != nil {
+				return 3, p.eraConversion(c, f[2])
+			}
+			_, err = p.directive("%Y" + f[3:])
+			return 3, err
+		case 'g':
+			if c := localeCalendar(l); c != nil {
+				return 3, p.eraConversion(c, 'g')
+			}
+			_, err = p.directive("%y")
+			return 3, err
+		case 'd', 'e', 'm', 'B', 'b', 'h', 'D', 'Z', 'S', 's':
+			if c := localeCalendar(l); c != nil {
+				if _, ok := c.(eraYears); !ok {
+					return 3, p.fail("calendar dates cannot be parsed")
+				}
+			}
+			switch f[2] {
+			case 'D':
+				_, err = p.directive("%d")
+			case 'Z', 'S', 's':
+				// empty in the Gregorian calendar
+			default:
+				_, err = p.directive("%" + f[2:])
+			}
+			return 3, err
+		case 'z', ':':
+			// Z is accepted for UTC with any offset format
+			if colons, c := colonConversion([]byte(f[2:])); c == 'z' {
+				return 3 + colons, p.offset()
+			}
+		}
+		return 0, nil
+	case 'O':
+		if len(f) < 3 {
+			return 0, nil
+		}
+		switch f[2] {
+		case 'C', 'd', 'e', 'g', 'G', 'H', 'I', 'j', 'k', 'l', 'm', 'M', 's', 'S', 'u', 'U', 'V', 'w', 'W', 'y', 'Y':
+			if hasAlgorithmicDigits(p.fm, f[2]) {
+				return 3, p.fail("alternative digits cannot be parsed")
+			}
+			if ns := p.fm.numbers; ns != nil && ns.Name != "latn" {
+				return 3, p.altDirective("%"+f[2:], ns)
+			}
+			_, err = p.directive("%" + f[2:])
+			return 3, err
+		case 'b', 'B', 'h':
+			_, err = p.directive("%B")
+			return 3, err
+		case 'E':
+			if len(f) < 4 || !strings.ContainsRune("CdemyY", rune(f[3])) {
+				return 0, nil
+			}
+			if hasAlgorithmicDigits(p.fm, f[3]) {
+				return 4, p.fail("alternative digits cannot be parsed")
+			}
+			if ns := p.fm.numbers; ns != nil && ns.Name != "latn" {
+				return 4, p.altDirective("%"+f[2:], ns)
+			}
+			_, err = p.directive("%" + f[2:])
+			return 4, err
+		}
+		return 0, nil
+	case '-', '_', '0', '^', '#', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		// flags and field width: numbers are always accepted without padding and
+		// names are matched regardless of case, so only padding has to be skipped,
+		// and the field width gives the maximum number of digits of numbers
+		s, ok := scanSpec(f)
+		if !ok {
+			return 0, nil
+		}
+		rest := len(p.s)
+		p.skipSpace()
+		if s.width > 0 && strings.IndexByte("CdefgGHIjklLmMNQsSuUVwWyY", f[s.letter]) != -1 {
+			// the field width includes the padding
+			p.width = max(s.width-(rest-len(p.s)), 1)
+		}
+		n, err := p.directive("%" + f[s.conv:])
+		p.width = 0
+		if n == 0 {
+			return 0, err
+		}
+		return s.conv - 1 + n, err
+	case 'a', 'A': // weekday name
+		_, err = p.lookup(l.Day[:], l.AbDay[:])
+	case 'b', 'B', 'h': // month name, in the form used in dates or standalone
+		var i int
+		i, err = p.lookup(l.Month[:], l.AbMonth[:], l.AltMonth[:], l.AbAltMonth[:])
+		p.month = i + 1
+		p.hasMonth = true
+	case 'c', 'D', 'F', 'r', 'R', 'T', 'v', 'x', 'X': // composite formats
+		err = p.run(compositeFormat(l, 0, f[1]))
+	case 'C':
+		p.century, err = p.signedNumber(2)
+		p.hasCentury = true
+	case 'd':
+		p.day, err = p.number(2, 1, 31)
+		p.hasDay = true
+	case 'e':
+		p.skipSpace()
+		p.day, err = p.number(2, 1, 31)
+		p.hasDay = true
+	case 'f':
+		err = p.fraction(6)
+	case 'L':
+		err = p.fraction(3)
+	case 'N':
+		err = p.fraction(9)
+	case 'g':
+		_, err = p.number(2, 0, 99)
+	case 'G':
+		_, err = p.signedNumber(yearDigits(f[2:]))
+	case 'H':
+		p.hour, err = p.hour24()
+	case 'I':
+		p.hour, err = p.hour12()
+	case 'j':
+		p.yday, err = p.number(3, 1, 366)
+		p.hasYday = true
+	case 'k':
+		p.skipSpace()
+		p.hour, err = p.hour24()
+	case 'l':
+		p.skipSpace()
+		p.hour, err = p.hour12()
+	case 'm':
+		p.month, err = p.number(2, 1, 12)
+		p.hasMonth = true
+	case 'M':
+		p.min, err = p.number(2, 0, 59)
+	case 'n', 't':
+		p.skipSpace()
+	case 'p', 'P':
+		var i int
+		i, err = p.lookup(l.AmPm[:])
+		p.pm = i
+	case 's':
+		var v int
+		v, err = p.signedNumber(19)
+		p.epoch = int64(v)
+		p.hasEpoch = true
+	case 'S':
+		p.sec, err = p.number(2, 0, 60)
+	case 'u':
+		_, err = p.number(1, 1, 7)
+	case 'U', 'W':
+		_, err = p.number(2, 0, 53)
+	case 'V':
+		_, err = p.number(2, 1, 53)
+	case 'w':
+		_, err = p.number(1, 0, 6)
+	case 'y':
+		p.yy, err = p.number(2, 0, 99)
+		p.hasYY = true
+	case 'Y':
+		p.year, err = p.signedNumber(yearDigits(f[2:]))
+		p.hasYear = true
+		if err == nil && p.fm.dualYear && p.fm.cutover != 0 && strings.HasPrefix(p.s, "/") && !strings.HasPrefix(f[2:], "/") {
+			err = p.dualYear()
+		}
+	case 'z', 'Q', ':':
+		colons, c := colonConversion([]byte(f[1:]))
+		switch {
+		case c == 'z':
+			err = p.offset()
+		case c == 'Q' && colons < 3:
+			err = p.epochFraction(colons)
+		default:
+			return 0, nil
+		}
+		return 2 + colons, err
+	case 'Z':
+		err = p.zone()
+	case '%':
+		if len(p.s) == 0 || p.s[0] != '%' {
+			return 2, p.fail("expected \"%\"")
+		}
+		p.s = p.s[1:]
+	default:
+		return 0, nil
+	}
+	return 2, err
+}
+
+// eraConversion parses era conversion c ('C', 'y', 'Y', 'r' or 'k') of calendar cal.
+// Era years can only be parsed for calendars whose years are Gregorian years counted
+// from another epoch (see eraYears), by matching the output of cal.AppendEra.
+func (p *strftimeParser) eraConversion(cal Calendar, c byte) error {
+	ey, ok := cal.(eraYears)
+	if !ok {
+		return p.fail("era years cannot be parsed")
+	}
+	tag := p.l.Tag
+	eras := ey.eras()
+	var err error
+	switch c {
+	case 'C':
+		var names []string
+		for _, era := range eras {
+			name, _ := cal.AppendEra(nil, tag, CalendarDate{Era: era, Year: 2}, 'C')
+			names = append(names, string(name))
+		}
+		var i int
+		if i, err = p.lookup(names); err == nil {
+			p.era = eras[i]
+		}
+	case 'y':
+		p.eraYear, err = p.number(9, 1, 999999999)
+		p.hasEraYear = true
+	case 'g':
+		// the century is chosen as for %y, so that the Gregorian year is in 1969-2068,
+		// which is only possible in calendars with a single era
+		if len(eras) != 1 {
+			return p.fail("two-digit era years cannot be parsed")
+		}
+		var yy int
+		if yy, err = p.number(2, 0, 99); err != nil {
+			return err
+		}
+		year := yy + (1969-ey.gregorianYear(eras[0], yy)+99)/100*100
+		p.era, p.eraYear, p.hasEraYear = eras[0], year, true
+	case 'Y', 'r', 'k':
+		// year 1 has a name in some calendars (民國元年, 平成元年)
+		for _, era := range eras {
+			first, _ := cal.AppendEra(nil, tag, CalendarDate{Era: era, Year: 1}, c)
+			if !strings.Contains(string(first), "1") && strings.HasPrefix(p.s, string(first)) {
+				p.s = p.s[len(first):]
+				p.era, p.eraYear, p.hasEraYear = era, 1, true
+				return nil
+			}
+		}
+
+		// other years are matched against the year 2 of each era, as in "พ.ศ. 2",
+		// "2 B.R.O.C." or "H2", keeping the era with the longest match
+		s, best, rest := p.s, -1, ""
+		for _, era := range eras {
+			second, _ := cal.AppendEra(nil, tag, CalendarDate{Era: era, Year: 2}, c)
+			prefix, suffix, _ := strings.Cut(string(second), "2")
+			if !strings.HasPrefix(s, prefix) {
+				continue
+			}
+			p.s = s[len(prefix):]
+			year, err := p.number(9, 1, 999999999)
+			if err == nil && strings.HasPrefix(p.s, suffix) && len(prefix)+len(suffix) > best {
+				best, rest = len(prefix)+len(suffix), p.s[len(suffix):]
+				p.era, p.eraYear = era, year
+			}
+		}
+		p.s = s
+		if best == -1 {
+			return p.fail("expected era year")
+		}
+		p.s, p.hasEraYear = rest, true
+	}
+	return err
+}
+
+// yearDigits returns the maximum number of digits of a year followed by format f: 4 if
+// f starts with a numeric conversion, so that compact formats such as %Y%m%d can be
+// read back as glibc strptime does, and 9 otherwise.
+func yearDigits(f string) int {
+	if len(f) < 2 || f[0] != '%' {
+		return 9
+	}
+	i := 1
+	for i < len(f) && (isFlag(f[i]) || (f[i] >= '0' && f[i] <= '9') || f[i] == 'E' || f[i] == 'O') {
+		i++
+	}
+	if i < len(f) && strings.IndexByte("CdegGHIjklmMsSuUVwWyY", f[i]) >= 0 {
+		return 4
+	}
+	return 9
+}
+
+// skipSpace skips any whitespace at the beginning of the remaining input.
+func (p *strftimeParser) skipSpace() {
+	for len(p.s) > 0 && isSpace(p.s[0]) {
+		p.s = p.s[1:]
+	}
+}
+
+// number reads an unsigned decimal number of at most width digits, or of the field width
+// of the conversion if it has one, and checks it falls within [min, max].
+func (p *strftimeParser) number(width, min, max int) (int, error) {
+	if p.width > 0 {
+		width = p.width
+	}
+	return p.digits(width, min, max)
+}
+
+// digits reads an unsigned decimal number of at most width digits and checks it falls
+// within [min, max].
+func (p *strftimeParser) digits(width, min, max int) (int, error) {
+	v, n := 0, 0
+	for n < width && n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		v = v*10 + int(p.s[n]-'0')
+		n++
+	}
+	if n == 0 {
+		return 0, p.fail("expected number")
+	}
+	if v < min || v > max {
+		return 0, p.fail("number out of range")
+	}
+	p.s = p.s[n:]
+	return v, nil
+}
+
+// signedNumber reads an optionally signed decimal number of at most width digits.
+func (p *strftimeParser) signedNumber(width int) (int, error) {
+	neg := false
+	if len(p.s) > 0 && (p.s[0] == '-' || p.s[0] == '+') {
+		neg = p.s[0] == '-'
+		p.s = p.s[1:]
+	}
+	v, err := p.number(width, 0, int(^uint(0)>>1))
+	if neg {
+		v = -v
+	}
+	return v, err
+}
+
+// dualYear reads the New Style part of a dual year such as 1720/21 (see
+// Formatter.WithDualYear), whose Old Style year was just read, and keeps the New Style
+// year.
+func (p *strftimeParser) dualYear() error {
+	p.s, p.width = p.s[1:], 0
+	y := p.year + 1
+	if floorDiv(p.year, 100) != floorDiv(y, 100) {
+		if v, err := p.signedNumber(9); err != nil || v != y {
+			return p.fail("dual year mismatch")
+		}
+	} else if v, err := p.fixed(2, 99); err != nil || v != y%100 {
+		return p.fail("dual year mismatch")
+	}
+	p.year = y
+	return nil
+}
+
+// hour24 reads an hour on a 24-hour clock, which goes up to 24 with the h24 hour cycle.
+func (p *strftimeParser) hour24() (int, error) {
+	if p.fm.hourCycle == "h24" {
+		h, err := p.number(2, 1, 24)
+		return h % 24, err
+	}
+	return p.number(2, 0, 23)
+}
+
+// hour12 reads an hour on a 12-hour clock, which starts at 0 with the h11 hour cycle.
+func (p *strftimeParser) hour12() (int, error) {
+	if p.fm.hourCycle == "h11" {
+		return p.number(2, 0, 11)
+	}
+	return p.number(2, 1, 12)
+}
+
+// fraction reads up to digits digits of fractional seconds, or the field width of the
+// conversion if it has one. Digits beyond nanoseconds are ignored.
+func (p *strftimeParser) fraction(digits int) error {
+	if p.width > 0 {
+		digits = p.width
+	}
+	v, n := 0, 0
+	for n < digits && n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		if n < 9 {
+			v = v*10 + int(p.s[n]-'0')
+		}
+		n++
+	}
+	if n == 0 {
+		return p.fail("expected fractional seconds")
+	}
+	p.s = p.s[n:]
+	for ; n < 9; n++ {
+		v *= 10
+	}
+	p.nsec = v
+	return nil
+}
+
+// epochFraction reads a number of milliseconds (0 colon), microseconds (1) or
+// nanoseconds (2) since the Unix epoch.
+func (p *strftimeParser) epochFraction(colons int) error {
+	v, err := p.signedNumber(19)
+	if err != nil {
+		return err
+	}
+	unit := int64(1000)
+	for ; colons > 0; colons-- {
+		unit *= 1000
+	}
+	p.epoch = int64(v) / unit
+	nsec := int64(v) % unit
+	if nsec < 0 {
+		p.epoch--
+		nsec += unit
+	}
+	p.nsec = int(nsec * (1e9 / unit))
+	p.hasEpoch = true
+	return nil
+}
+
+// altDirective matches numeric conversion f against input written with the digits of
+// decimal numbering system ns (ASCII digits are also accepted).
+func (p *strftimeParser) altDirective(f string, ns *NumberingSystem) error {
+	// convert the digits found at the start of the input, remembering where each one
+	// ends in the original input
+	rest := p.s
+	var ascii []byte
+	var ends []int
+	for n := 0; n < len(rest); {
+		d, size := -1, 1
+		if c := rest[n]; c == ' ' || c == '-' || c == '+' || (c >= '0' && c <= '9') {
+			d = int(c)
+		} else {
+			for i, digit := range ns.Digits {
+				if strings.HasPrefix(rest[n:], digit) {
+					d, size = '0'+i, len(digit)
+					break
+				}
+			}
+		}
+		if d == -1 {
+			break
+		}
+		ascii = append(ascii, byte(d))
+		n += size
+		ends = append(ends, n)
+	}
+
+	p.s = string(ascii)
+	_, err := p.directive(f)
+	used := 0
+	if n := len(ascii) - len(p.s); n > 0 {
+		used = ends[n-1]
+	}
+	p.s = rest[used:]
+	if e, ok := err.(*ParseError); ok {
+		// report the error at its offset in the original input
+		return p.fail(e.Message)
+	}
+	return err
+}
+
+// lookup finds the longest name from the given lists matching the beginning of the
+// remaining input, ignoring case, and returns its index within its list.
+func (p *strftimeParser) lookup(lists ...[]string) (int, error) {
+	found, best, empty := -1, 0, -1
+	for _, list := range lists {
+		for i, name := range list {
+			if name == "" {
+				empty = i
+				continue
+			}
+			for _, n := range [...]string{name, strings.TrimSpace(name)} {
+				if len(n) > best && len(n) <= len(p.s) && strings.EqualFold(p.s[:len(n)], n) {
+					found, best = i, len(n)
+				}
+			}
+		}
+	}
+	if found == -1 {
+		if empty != -1 {
+			// the locale has no name for this, which is also what Format outputs
+			return -1, nil
+		}
+		return -1, p.fail("unknown name")
+	}
+	p.s = p.s[best:]
+	return found, nil
+}
+
+// offset reads a time zone offset in the form +hhmm, +hh:mm, +hh or Z, with optional
+// seconds (+hhmmss or +hh:mm:ss).
+func (p *strftimeParser) offset() error {
+	if len(p.s) > 0 && (p.s[0] == 'Z' || p.s[0] == 'z') {
+		p.s = p.s[1:]
+		p.zoneOffset, p.hasZoneOffset = 0, true
+		return nil
+	}
+	if len(p.s) == 0 || (p.s[0] != '+' && p.s[0] != '-') {
+		return p.fail("expected time zone offset")
+	}
+	neg := p.s[0] == '-'
+	p.s = p.s[1:]
+
+	h, err := p.fixed(2, 23)
+	if err != nil {
+		return err
+	}
+	// minutes and seconds are optional, and separated by colons if the minutes are
+	m, sec := 0, 0
+	colon := len(p.s) > 0 && p.s[0] == ':'
+	for i, v := range []*int{&m, &sec} {
+		if colon {
+			if len(p.s) < 2 || p.s[0] != ':' || (i > 0 && (p.s[1] < '0' || p.s[1] > '9')) {
+				break
+			}
+			p.s = p.s[1:]
+		} else if len(p.s) < 2 || p.s[0] < '0' || p.s[0] > '9' || p.s[1] < '0' || p.s[1] > '9' {
+			break
+		}
+		if *v, err = p.fixed(2, 59); err != nil {
+			return err
+		}
+	}
+
+	p.zoneOffset = (h*60+m)*60 + sec
+	if neg {
+		p.zoneOffset = -p.zoneOffset
+	}
+	p.hasZoneOffset = true
+	return nil
+}
+
+// fixed reads a number of exactly width digits not larger than max.
+func (p *strftimeParser) fixed(width, max int) (int, error) {
+	for i := 0; i < width; i++ {
+		if i >= len(p.s) || p.s[i] < '0' || p.s[i] > '9' {
+			return 0, p.fail("expected number")
+		}
+	}
+	return p.digits(width, 0, max)
+}
+
+// zone reads a time zone abbreviation such as UTC, JST or +09.
+func (p *strftimeParser) zone() error {
+	n := 0
+	if len(p.s) > 0 && (p.s[0] == '+' || p.s[0] == '-') {
+		n = 1
+		for n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+			n++
+		}
+	} else {
+		for n < len(p.s) && ((p.s[n] >= 'A' && p.s[n] <= 'Z') || (p.s[n] >= 'a' && p.s[n] <= 'z')) {
+			n++
+		}
+	}
+	if n < 2 {
+		return p.fail("expected time zone name")
+	}
+	p.zoneName = p.s[:n]
+	p.s = p.s[n:]
+	return nil
+}
+
+// time assembles the parsed values into a time.Time, using loc when the input
+// carried no time zone offset.
+func (p *strftimeParser) time(loc *time.Location) (time.Time, error) {
+	if p.hasEpoch {
+		t := time.Unix(p.epoch, int64(p.nsec))
+		if p.hasZoneOffset {
+			return t.In(time.FixedZone(p.zoneName, p.zoneOffset)), nil
+		}
+		return t.In(loc), nil
+	}
+
+	year := p.year
+	switch {
+	case p.hasYear:
+	case p.hasEraYear:
+		year = localeCalendar(p.l).(eraYears).gregorianYear(p.era, p.eraYear)
+	case p.hasCentury && p.hasYY:
+		year = p.century*100 + p.yy
+	case p.hasCentury:
+		year = p.century * 100
+	case p.hasYY:
+		// POSIX: values 69-99 refer to 1969-1999, 00-68 to 2000-2068
+		year = 1900 + p.yy
+		if p.yy < 69 {
+			year += 100
+		}
+	}
+
+	hour := p.hour
+	if p.pm != -1 {
+		hour = hour%12 + 12*p.pm
+	}
+
+	month, day := p.month, p.day
+	switch {
+	case p.fm.cutover != 0:
+		// historical date, in the calendar in use at that date
+		var err error
+		if year, month, day, err = p.civilDate(year, month, day); err != nil {
+			return time.Time{}, err
+		}
+	case p.hasYday && !p.hasMonth && !p.hasDay:
+		d := time.Date(year, time.January, p.yday, 0, 0, 0, 0, time.UTC)
+		if d.Year() != year {
+			return time.Time{}, p.fail("day of year out of range")
+		}
+		month, day = int(d.Month()), d.Day()
+	}
+
+	if day > daysIn(time.Month(month), year) {
+		return time.Time{}, p.fail("day out of range")
+	}
+
+	switch {
+	case p.hasZoneOffset:
+		t := time.Date(year, time.Month(month), day, hour, p.min, p.sec, p.nsec, loc)
+		if name, offset := t.Zone(); offset == p.zoneOffset && (p.zoneName == "" || p.zoneName == name) {
+			// offset matches the provided location, use it
+			break
+		}
+		if p.zoneOffset == 0 && (p.zoneName == "" || p.zoneName == "UTC") {
+			loc = time.UTC
+		} else {
+			loc = time.FixedZone(p.zoneName, p.zoneOffset)
+		}
+	case p.zoneName == "UTC" || p.zoneName == "GMT":
+		loc = time.UTC
+	case p.zoneName != "":
+		t := time.Date(year, time.Month(month), day, hour, p.min, p.sec, p.nsec, loc)
+		if name, _ := t.Zone(); name == p.zoneName {
+			return t, nil
+		}
+		// unknown zone abbreviation, record it with a zero offset like time.Parse does
+		loc = time.FixedZone(p.zoneName, 0)
+	}
+
+	return time.Date(year, time.Month(month), day, hour, p.min, p.sec, p.nsec, loc), nil
+}
+
+// civilDate converts the parsed date (or day of the year, if only it was given), which
+// is in the Julian calendar before the Gregorian cutover of the locale, to a date of the
+// proleptic Gregorian calendar. Days skipped by the reform are out of range.
+func (p *strftimeParser) civilDate(year, month, day int) (int, int, int, error) {
+	var jdn int
+	if p.hasYday && !p.hasMonth && !p.hasDay {
+		jdn = civilDayNumber(p.fm, year, time.January, 1) + p.yday - 1
+		if y, _, _ := civilFromDayNumber(p.fm, jdn); y != year {
+			return 0, 0, 0, p.fail("day of year out of range")
+		}
+	} else {
+		jdn = civilDayNumber(p.fm, year, time.Month(month), day)
+		if y, m, d := civilFromDayNumber(p.fm, jdn); y != year || int(m) != month || d != day {
+			return 0, 0, 0, p.fail("day out of range")
+		}
+	}
+	y, m, d := dateFromDayNumber(jdn)
+	return y, int(m), d, nil
+}
+
+// daysIn returns the number of days in month m of year y.
+func daysIn(m time.Month, y int) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isSpace reports whether c is an ASCII whitespace character.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
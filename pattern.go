@@ -0,0 +1,206 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// Pattern is a pre-compiled format string bound to a locale. Compiling a pattern once
+// and reusing it avoids scanning the format string on every call, which makes it the
+// fastest way to format many times with the same format.
+//
+// A Pattern is safe for concurrent use by multiple goroutines.
+type Pattern struct {
+	fm    *Formatter
+	ins   []instruction
+	size  int           // expected output size, used for buffer allocation
+	round time.Duration // precision to round times to, if rounding is enabled
+}
+
+// instruction is a single step of a compiled Pattern: either literal text to output
+// as is, or a conversion specification to format, decoded at compile time.
+type instruction struct {
+	lit  []byte   // literal text, used when conv is nil
+	conv []byte   // conversion specification without flags or field width, after a % sign
+	spec convSpec // flags and field width of the conversion specification
+}
+
+// Compile parses a format string once for the English locale and returns a Pattern
+// that can be used to format times without parsing the format again.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//
+// Returns: The compiled Pattern, or a *FormatError if f is not valid
+func Compile(f string) (*Pattern, error) {
+	return EnglishFormatter.Compile(f)
+}
+
+// MustCompile is like Compile but panics if the format cannot be compiled.
+// It simplifies safe initialization of global variables holding compiled patterns.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//
+// Returns: The compiled Pattern
+func MustCompile(f string) *Pattern {
+	p, err := Compile(f)
+	if err != nil {
+		panic(`strftime: Compile(` + f + `): ` + err.Error())
+	}
+	return p
+}
+
+// Compile parses a format string once for this Formatter's locale and returns a Pattern.
+// Composite conversions such as %c, %x, %D, %T or %F are expanded using the locale at
+// compile time.
+//
+// Unlike Format, Compile rejects unknown or truncated conversion specifications.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//
+// Returns: The compiled Pattern, or a *FormatError if f is not valid
+func (obj *Formatter) Compile(f string) (*Pattern, error) {
+	if err := obj.validate(f); err != nil {
+		return nil, err
+	}
+
+	p := &Pattern{fm: obj}
+	p.compile(f)
+	if obj.round {
+		p.round = fractionUnit(fractionPrecision(obj, f))
+	}
+
+	for _, ins := range p.ins {
+		if ins.conv == nil {
+			p.size += len(ins.lit)
+		} else {
+			p.size += 4 // average length of a formatted conversion
+		}
+	}
+	if p.size < 64 {
+		p.size = 64 // Minimum size to avoid small allocations
+	}
+	return p, nil
+}
+
+// compile tokenizes f into the pattern's instruction list, recursively expanding
+// composite conversion specifications.
+func (p *Pattern) compile(f string) {
+	for len(f) > 0 {
+		i := strings.IndexByte(f, '%')
+		if i == -1 {
+			p.literal(f)
+			return
+		}
+		if i > 0 {
+			p.literal(f[:i])
+			f = f[i:]
+		}
+		// at this point, f always starts with a % symbol
+
+		s, ok := scanSpec(f)
+		if !ok {
+			p.literal(f)
+			return
+		}
+		if !p.fm.knownSpec(f, s) {
+			// not recognized, output % as is
+			p.literal(f[:1])
+			f = f[1:]
+			continue
+		}
+
+		mod, c := f[s.conv:s.letter], f[s.letter]
+		if s.conv == 1 && (mod == "" || mod == "E") {
+			// flags and field width apply to the whole composite result, so only
+			// specifications without them are expanded
+			var m byte
+			if mod == "E" {
+				m = 'E'
+			}
+			if sub := p.fm.composite(m, c); sub != "" {
+				p.compile(sub)
+				f = f[s.end:]
+				continue
+			}
+		}
+
+		switch {
+		case s.conv == 1 && mod == "" && c == 'n':
+			p.literal("\n")
+		case s.conv == 1 && mod == "" && c == 't':
+			p.literal("\t")
+		case s.conv == 1 && mod == "" && c == '%':
+			p.literal("%")
+		default:
+			conv := append([]byte{'%'}, f[s.conv:s.end]...)
+			p.ins = append(p.ins, instruction{conv: conv, spec: s})
+		}
+		f = f[s.end:]
+	}
+}
+
+// literal adds literal text to the pattern, merging it with the previous instruction
+// when possible.
+func (p *Pattern) literal(s string) {
+	if n := len(p.ins); n > 0 && p.ins[n-1].conv == nil {
+		p.ins[n-1].lit = append(p.ins[n-1].lit, s...)
+		return
+	}
+	p.ins = append(p.ins, instruction{lit: []byte(s)})
+}
+
+// Format formats time t using the compiled pattern, and returns a string.
+//
+// Parameters:
+//   - t: Time value to format
+//
+// Returns: Formatted time string
+func (p *Pattern) Format(t time.Time) string {
+	return string(p.AppendFormat(make([]byte, 0, p.size), t))
+}
+
+// AppendFormat is like Format but appends the textual representation to b and returns the extended buffer.
+//
+// Parameters:
+//   - b: Byte slice to append the formatted time to
+//   - t: Time value to format
+//
+// Returns: The extended byte slice containing the original content followed by the formatted time
+func (p *Pattern) AppendFormat(b []byte, t time.Time) []byte {
+	if p.fm.loc != nil {
+		t = t.In(p.fm.loc)
+	}
+	if p.round > 0 {
+		t = t.Round(p.round)
+	}
+	for i := range p.ins {
+		ins := &p.ins[i]
+		switch {
+		case ins.conv == nil:
+			b = append(b, ins.lit...)
+		case ins.spec.conv == 1:
+			// no flags or field width
+			b, _ = appendConversion(p.fm, b, ins.conv, t)
+		default:
+			b, _ = appendSpec(p.fm, b, ins.conv, ins.spec, t)
+		}
+	}
+	return b
+}
+
+// FormatF formats time t using the compiled pattern, and outputs it to the provided io.Writer.
+//
+// Parameters:
+//   - o: io.Writer to write the formatted output to
+//   - t: Time value to format
+//
+// Returns: Error if writing to the io.Writer fails
+func (p *Pattern) FormatF(o io.Writer, t time.Time) error {
+	_, err := o.Write(p.AppendFormat(make([]byte, 0, p.size), t))
+	return err
+}
@@ -14,12 +14,32 @@ import (
 // Formatter represents a time formatter with specific locale settings.
 // It handles the formatting of time values according to the specified locale.
 type Formatter struct {
-	l *strftimeLocaleInfo
+	l *Locale
+
+	// settings changed through the With* methods or the Unicode extensions of the
+	// language tag given to New
+	round     bool             // round fractional seconds instead of truncating them
+	posix     bool             // use the POSIX expansions of %D, %r and %R
+	hourCycle string           // hour cycle (h11, h12, h23 or h24), empty for the locale default
+	numbers   *NumberingSystem // numbering system of %O, nil for the locale's Oprint or ASCII digits
+	loc       *time.Location   // location times are converted to before formatting, if not nil
+	cutover   int              // Julian day number of the first Gregorian day, 0 for the proleptic Gregorian calendar
+	dualYear  bool             // write Old Style and New Style years of Julian dates, as in 1720/21
+	weekStart int              // first day of the weeks of %W, in days after Monday
 }
 
 // EnglishFormatter is a pre-initialized English locale formatter.
 // It can be used directly without calling New() for English locale formatting.
-var EnglishFormatter = &Formatter{englishLocale}
+var EnglishFormatter = &Formatter{l: englishLocale}
+
+// newFormatter returns a Formatter for locale l with the default settings.
+func newFormatter(l *Locale) *Formatter {
+	fm := &Formatter{l: l}
+	if l.Oprint == nil && l.Numbering != "" {
+		fm.numbers = lookupNumberingSystem(l.Numbering)
+	}
+	return fm
+}
 
 // Format is a shortcut to format a date in a given locale easily.
 // Best performance is achieved by using language constants such as
@@ -32,12 +52,10 @@ var EnglishFormatter = &Formatter{englishLocale}
 //
 // Returns: Formatted time string according to the specified locale and format
 func Format(l language.Tag, f string, t time.Time) string {
-	locale, ok := strftimeLocaleTable[l]
-	if !ok {
-		// need to match locale
-		_, i, _ := strftimeLocaleMatcher.Match(l)
-		locale = strftimeLocales[i]
+	if hasExtensions(l) {
+		return New(l).Format(f, t)
 	}
+	fm := newFormatter(lookupLocale(l))
 
 	// Initial capacity calculation: format string + some extra space
 	// We use a multiplier of 1.5 as a reasonable estimate for expansion ratio
@@ -46,7 +64,7 @@ func Format(l language.Tag, f string, t time.Time) string {
 		initialCap = 64 // Minimum size to avoid small allocations
 	}
 
-	b := appendStrftime(locale, make([]byte, 0, initialCap), []byte(f), t)
+	b := appendStrftime(fm, make([]byte, 0, initialCap), []byte(f), t)
 	return string(b)
 }
 
@@ -65,7 +83,7 @@ func EnFormat(f string, t time.Time) string {
 		initialCap = 64 // Minimum size to avoid small allocations
 	}
 
-	b := appendStrftime(englishLocale, make([]byte, 0, initialCap), []byte(f), t)
+	b := appendStrftime(EnglishFormatter, make([]byte, 0, initialCap), []byte(f), t)
 	return string(b)
 }
 
@@ -85,7 +103,7 @@ func EnFormatF(o io.Writer, f string, t time.Time) error {
 		initialCap = 64 // Minimum size to avoid small allocations
 	}
 
-	b := appendStrftime(englishLocale, make([]byte, 0, initialCap), []byte(f), t)
+	b := appendStrftime(EnglishFormatter, make([]byte, 0, initialCap), []byte(f), t)
 	_, err := o.Write(b)
 	return err
 }
@@ -98,20 +116,45 @@ func EnFormatF(o io.Writer, f string, t time.Time) error {
 //	t, q, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
 //	f := strftime.New(t...)
 //
+// The following Unicode extensions of the language tag are honored (see
+// https://www.unicode.org/reports/tr35/#Key_Type_Definitions), as in en-GB-u-hc-h12:
+//   - hc (hour cycle): h12 or h11 for a 12-hour clock in %c and %X, h23 or h24 for a
+//     24-hour clock. With h11, %I ranges from 0 to 11 and with h24, %H from 1 to 24
+//   - nu (numbering system): digits used by %O, such as arab, deva, thai or jpan (see
+//     RegisterNumberingSystem)
+//   - ca (calendar): gregory, buddhist, japanese or roc for era conversions (%E), see
+//     RegisterCalendar
+//   - tz (time zone): BCP 47 time zone such as usnyc or jptyo, times are converted to
+//     that zone before formatting
+//   - fw (first day of week): sun, mon... sat, the day %W weeks start on instead of
+//     Monday. %U weeks always start on Sunday, and %V follows ISO 8601
+//
+// Unsupported values are ignored, CheckExtensions reports them.
+//
 // Parameters:
 //   - l: One or more language tags to match against known locales
 //
 // Returns: A new Formatter instance configured for the best matching locale
 func New(l ...language.Tag) *Formatter {
+	locale, tag := matchLocale(l)
+	return applyExtensions(newFormatter(locale), tag)
+}
+
+// matchLocale returns the locale best matching the given language tags, along with
+// the tag it was selected for, whose Unicode extensions apply to the formatter.
+func matchLocale(l []language.Tag) (*Locale, language.Tag) {
+	strftimeLocaleLock.RLock()
+	defer strftimeLocaleLock.RUnlock()
+
 	if len(l) == 0 {
 		// No language specified, use English as default
-		return &Formatter{englishLocale}
+		return strftimeResolved[0], language.Und
 	}
 
 	// Step 1: Try a direct match first for each provided tag (highest priority)
 	for _, tag := range l {
-		if locale, ok := strftimeLocaleTable[tag]; ok {
-			return &Formatter{locale}
+		if locale, ok := strftimeLocaleTable[stripExtensions(tag)]; ok {
+			return locale, tag
 		}
 	}
 
@@ -127,31 +170,60 @@ func New(l ...language.Tag) *Formatter {
 
 	// If we have any valid tags, use those for matching
 	if len(validTags) > 0 {
-		// Step 3: Try base language matches for all valid tags
+		// Step 3: Try regional and base language matches for all valid tags
 		for _, tag := range validTags {
+			// Prefer the closest regional locale (e.g., "es-419" for "es-CO")
+			if locale, ok := regionalLocale(stripExtensions(tag)); ok {
+				return locale, tag
+			}
+
 			// Extract just the language part (e.g., "en" from "en-US")
 			base, _ := tag.Base()
 			baseLang := language.Make(base.String())
 
 			// Check for base language match in our supported locales
 			if locale, ok := strftimeLocaleTable[baseLang]; ok {
-				return &Formatter{locale}
+				return locale, tag
 			}
 
 			// Also try extended matches via the matcher for this specific tag
 			_, index, conf := strftimeLocaleMatcher.Match(baseLang)
 			if conf >= language.High {
-				return &Formatter{strftimeLocales[index]}
+				return strftimeResolved[index], tag
 			}
 		}
 
 		// Step 4: If no direct base language match, use language matcher with all valid tags
 		_, index, _ := strftimeLocaleMatcher.Match(validTags...)
-		return &Formatter{strftimeLocales[index]}
+		return strftimeResolved[index], validTags[0]
 	}
 
 	// Step 5: Fallback to default English if no valid tags provided
-	return &Formatter{englishLocale}
+	return strftimeResolved[0], l[0]
+}
+
+// regionalLocale looks up tag in the locale table, first without a script that is the
+// default for its region (so that "zh-Hant-HK" finds "zh-HK"), then walking up its
+// parent locales (such as "es-419" for "es-MX"). The caller must hold strftimeLocaleLock.
+func regionalLocale(tag language.Tag) (*Locale, bool) {
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	if region, conf := tag.Region(); conf == language.Exact {
+		if t, err := language.Compose(base, region); err == nil && t != tag {
+			if s, _ := t.Script(); s == script {
+				if locale, ok := strftimeLocaleTable[t]; ok {
+					return locale, true
+				}
+			}
+		}
+	}
+
+	for p := tag.Parent(); p != language.Und; p = p.Parent() {
+		if locale, ok := strftimeLocaleTable[p]; ok {
+			return locale, true
+		}
+	}
+	return nil, false
 }
 
 // Format formats time using provided format, and returns a string.
@@ -169,7 +241,7 @@ func (obj *Formatter) Format(f string, t time.Time) string {
 		initialCap = 64 // Minimum size to avoid small allocations
 	}
 
-	b := appendStrftime(obj.l, make([]byte, 0, initialCap), []byte(f), t)
+	b := appendStrftime(obj, make([]byte, 0, initialCap), []byte(f), adjustTime(obj, f, t))
 	return string(b)
 }
 
@@ -183,7 +255,7 @@ func (obj *Formatter) Format(f string, t time.Time) string {
 //
 // Returns: The extended byte slice containing the original content followed by the formatted time
 func (obj *Formatter) AppendFormat(b []byte, f string, t time.Time) []byte {
-	return appendStrftime(obj.l, b, []byte(f), t)
+	return appendStrftime(obj, b, []byte(f), adjustTime(obj, f, t))
 }
 
 // FormatF formats time using provided format, and outputs it to the provided io.Writer.
@@ -202,7 +274,165 @@ func (obj *Formatter) FormatF(o io.Writer, f string, t time.Time) error {
 		initialCap = 64 // Minimum size to avoid small allocations
 	}
 
-	b := appendStrftime(obj.l, make([]byte, 0, initialCap), []byte(f), t)
+	b := appendStrftime(obj, make([]byte, 0, initialCap), []byte(f), adjustTime(obj, f, t))
 	_, err := o.Write(b)
 	return err
 }
+
+// FormatStrict is like Format but returns an error instead of copying unknown or
+// truncated conversion specifications to the output.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//   - t: Time value to format
+//
+// Returns: Formatted time string, or a *FormatError if f is not valid
+func (obj *Formatter) FormatStrict(f string, t time.Time) (string, error) {
+	if err := obj.validate(f); err != nil {
+		return "", err
+	}
+	return obj.Format(f, t), nil
+}
+
+// AppendFormatStrict is like AppendFormat but returns an error instead of copying
+// unknown or truncated conversion specifications to the output. On error, b is
+// returned unchanged.
+//
+// Parameters:
+//   - b: Byte slice to append the formatted time to
+//   - f: Format string with strftime-compatible format specifiers
+//   - t: Time value to format
+//
+// Returns: The extended byte slice, or a *FormatError if f is not valid
+func (obj *Formatter) AppendFormatStrict(b []byte, f string, t time.Time) ([]byte, error) {
+	if err := obj.validate(f); err != nil {
+		return b, err
+	}
+	return obj.AppendFormat(b, f, t), nil
+}
+
+// WithPOSIX returns a copy of the Formatter in which %D, %r and %R always expand to
+// their POSIX C locale definitions (%m/%d/%y, %I:%M:%S %p and %H:%M), as they did in
+// earlier versions of this package, instead of the locale's short date, 12-hour time
+// and hours and minutes formats.
+//
+// Parameters:
+//   - posix: true to use the POSIX expansions, false to use the locale ones (the default)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithPOSIX(posix bool) *Formatter {
+	res := *obj
+	res.posix = posix
+	return &res
+}
+
+// WithCalendar returns a copy of the Formatter that uses the given calendar for the
+// conversions with the E modifier (such as %EY, %Ed or %EB), like the ca Unicode
+// extension does. "gregory" selects the Gregorian calendar, in which era formats are the
+// same as the normal ones. Unknown calendars are ignored.
+//
+// Parameters:
+//   - name: CLDR identifier of the calendar, such as "gregory", "buddhist", "japanese"
+//     or "roc", or "julian" for the Julian calendar (see RegisterCalendar)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithCalendar(name string) *Formatter {
+	res := *obj
+	if c, ok := lookupCalendar(name); ok {
+		// the calendar changes the era formats, which are locale data
+		l := *obj.l
+		setCalendar(&l, c)
+		res.l = &l
+	}
+	return &res
+}
+
+// Dates of the first day of the Gregorian calendar in a few countries, for
+// Formatter.WithGregorianCutover.
+var (
+	// GregorianCutoverRome is the Gregorian reform of 1582 (Italy, Spain, Portugal and
+	// Poland), where October 4th (Julian) was followed by October 15th.
+	GregorianCutoverRome = time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+	// GregorianCutoverBritain is the reform of 1752 (Great Britain and its colonies),
+	// where September 2nd (Julian) was followed by September 14th.
+	GregorianCutoverBritain = time.Date(1752, time.September, 14, 0, 0, 0, 0, time.UTC)
+
+	// GregorianCutoverRussia is the reform of 1918 (Soviet Russia), where January 31st
+	// (Julian) was followed by February 14th.
+	GregorianCutoverRussia = time.Date(1918, time.February, 14, 0, 0, 0, 0, time.UTC)
+)
+
+// WithGregorianCutover returns a copy of the Formatter in which dates before the given
+// cutover are formatted in the Julian calendar, as they were written at the time, and
+// dates from the cutover in the Gregorian calendar. This affects the day, month, year
+// and day of the year conversions (such as %d, %B, %Y or %j) and the E conversions when
+// no calendar is set, but not the weekday nor the ISO 8601 week-based year and week
+// (%G and %V). Parse reads dates in the same calendars, and rejects the days skipped by
+// the reform.
+//
+// Parameters:
+//   - cutover: First day of the Gregorian calendar (only its date matters), such as
+//     GregorianCutoverRome or GregorianCutoverBritain, or the zero time for the
+//     proleptic Gregorian calendar (the default)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithGregorianCutover(cutover time.Time) *Formatter {
+	res := *obj
+	res.cutover = 0
+	if !cutover.IsZero() {
+		res.cutover = dayNumber(cutover.Date())
+	}
+	return &res
+}
+
+// WithDualYear returns a copy of the Formatter in which %Y gives both the Old Style and
+// New Style years of Julian calendar dates from January 1st to March 24th, as in
+// 1720/21, for the time when the year started on March 25th. It only has an effect on
+// dates before the cutover set with WithGregorianCutover. %Y with flags or a field width,
+// %F and the other numeric year conversions give the New Style year alone. Parse accepts
+// both dual and single years for %Y.
+//
+// Parameters:
+//   - dual: true to write dual years, false to write the year starting on January 1st
+//     (the default)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithDualYear(dual bool) *Formatter {
+	res := *obj
+	res.dualYear = dual
+	return &res
+}
+
+// WithNumberingSystem returns a copy of the Formatter in which %O conversions write
+// numbers with the given numbering system instead of the locale's default, like the nu
+// Unicode extension does. Unknown numbering systems are ignored.
+//
+// Parameters:
+//   - name: CLDR identifier of the numbering system, such as "arab", "deva", "fullwide",
+//     "hanidec", "jpan" or "latn" for ASCII digits (see RegisterNumberingSystem)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithNumberingSystem(name string) *Formatter {
+	res := *obj
+	if ns := lookupNumberingSystem(name); ns != nil {
+		res.numbers = ns
+	}
+	return &res
+}
+
+// WithRounding returns a copy of the Formatter that rounds times to the precision of
+// the fractional seconds in the format (such as %3N or %L) instead of truncating them.
+// The time is rounded once, so that all the fields of the result are consistent: with
+// rounding, 23:59:59.9996 formatted with "%T.%L" gives 00:00:00.000 of the next day.
+// Formats without fractional seconds are not affected.
+//
+// Parameters:
+//   - round: true to round times, false to truncate them (the default)
+//
+// Returns: A new Formatter with the same locale and settings
+func (obj *Formatter) WithRounding(round bool) *Formatter {
+	res := *obj
+	res.round = round
+	return &res
+}
@@ -2,6 +2,8 @@ package strftime_test
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -138,6 +140,12 @@ func TestJapanese(t *testing.T) {
 		{`%OH`, `二十二`, ref},
 		{`%OI`, `十`, ref},
 		{`%Od %Om %OH:%OM:%OS %OV %OW %Ow`, `二 一 二十二:四:五 一 一 一`, ref},
+		{`%Er %Ek`, `H18 平18`, ref},
+		{`%Er.%m.%d`, `R6.05.20`, time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)},
+		{`%Ek`, `令1`, time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{`%Er %Ek`, `S64 昭64`, time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{`%Er %Ek`, `M1 明1`, time.Date(1868, 10, 23, 0, 0, 0, 0, time.UTC)},
+		{`%Er %Ek %EY`, `1801 1801 西暦1801年`, time.Date(1801, 1, 1, 0, 0, 0, 0, time.UTC)},
 	}
 
 	for _, x := range cmp {
@@ -145,6 +153,31 @@ func TestJapanese(t *testing.T) {
 	}
 }
 
+// TestRegisterJapaneseEra checks that registered eras are used by the Japanese calendar
+func TestRegisterJapaneseEra(t *testing.T) {
+	t.Cleanup(strftime.ResetJapaneseEras)
+	f := strftime.New(language.Japanese)
+
+	assert.Error(t, strftime.RegisterJapaneseEra(``, `X`, time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)), `registering era without name`)
+	assert.NoError(t, strftime.RegisterJapaneseEra(`未来`, `F`, time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)), `registering future era`)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%EY %Er %Ek`, `令和981年 R981 令981`, time.Date(2999, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{`%EY %Er %Ek`, `未来元年 F1 未1`, time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{`%Ex`, `未来2年01月01日`, time.Date(3001, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T), `matching for `+x.A)
+	}
+
+	strftime.ResetJapaneseEras()
+	assert.Equal(t, `令和982年`, f.Format(`%EY`, time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)), `after resetting the eras`)
+}
+
 func TestFrench(t *testing.T) {
 	ref := time.Unix(1136239445, 456841962).UTC()
 	f := strftime.New(language.French)
@@ -153,7 +186,8 @@ func TestFrench(t *testing.T) {
 		A, B string
 		T    time.Time
 	}{
-		{`%p %P`, ` `, ref},
+		{`%p %P`, `PM pm`, ref},
+		{`%r`, `10:04:05 PM`, ref},
 		{`%A %d %B %Y`, `lundi 02 janvier 2006`, ref},
 		{`%a %d %b %Y`, `lun. 02 janv. 2006`, ref},
 		{`%x`, `02/01/2006`, ref},
@@ -196,8 +230,10 @@ func TestRussian(t *testing.T) {
 	}{
 		{`%A`, `Понедельник`, ref},
 		{`%a`, `Пн`, ref},
-		{`%B`, `Январь`, ref},
+		{`%B`, `января`, ref},
 		{`%b`, `янв`, ref},
+		{`%OB`, `Январь`, ref},
+		{`%d %B %Y`, `02 января 2006`, ref},
 		{`%x`, `02.01.2006`, ref},
 		{`%c`, `Пн 02 янв 2006 22:04:05`, ref},
 	}
@@ -212,8 +248,9 @@ func TestLocale(t *testing.T) {
 
 	cmp := []struct{ A, B string }{
 		{`nn;q=0.3, en-us;q=0.8, en,`, `Mon Jan  2 22:04:05 2006`},
-		{`gsw, en;q=0.7, en-US;q=0.8`, `Mon Jan  2 22:04:05 2006`},
-		{`gsw, nl, da`, `ma 02 jan 2006 22:04:05 UTC`},
+		{`tlh, en;q=0.7, en-US;q=0.8`, `Mon Jan  2 22:04:05 2006`},
+		{`tlh, nl, da`, `ma 02 jan 2006 22:04:05 UTC`},
+		{`gsw, nl, da`, `02.01.2006 22:04:05`},
 		{`fr`, `lun. 02 janv. 2006 22:04:05 UTC`},
 		{`invalid`, `Mon Jan  2 22:04:05 2006`},
 	}
@@ -360,9 +397,8 @@ func TestLocaleDetection(t *testing.T) {
 		{"Simple German", "de", language.German, "Januar", "Montag"},
 		{"Quality Values", "fr;q=0.8, en;q=0.7", language.French, "janvier", "lundi"},
 		{"Multiple Locales with Quality", "es;q=0.5, it;q=0.9", language.Italian, "gennaio", "lunedì"},
-		// Note: actual behavior depends on the implementation of strftimeLocaleMatcher
-		// With the current implementation, it selects Spanish for the Brazilian Portuguese tag
-		{"Complex Chain", "pt-BR, es;q=0.8, en-US;q=0.6, en;q=0.4", language.Spanish, "enero", "lunes"},
+		{"Complex Chain", "pt-BR, es;q=0.8, en-US;q=0.6, en;q=0.4", language.BrazilianPortuguese, "Janeiro", "Segunda"},
+		{"Regional Fallback", "es-CO", language.MustParse("es-419"), "enero", "lunes"},
 	}
 
 	for _, tc := range localeTests {
@@ -393,7 +429,7 @@ func TestFormatErrors(t *testing.T) {
 	assert.Equal(t, "Test % string", f.Format("Test % string", ref), "Single % in middle should remain as %")
 
 	// Test unknown format specifiers
-	assert.Equal(t, "Test %Q string", f.Format("Test %Q string", ref), "Unknown specifier %Q should remain as %Q")
+	assert.Equal(t, "Test %K string", f.Format("Test %K string", ref), "Unknown specifier %K should remain as %K")
 
 	// Test incomplete modifiers
 	assert.Equal(t, "Test %E string", f.Format("Test %E string", ref), "Incomplete %E should remain as %E")
@@ -426,3 +462,1302 @@ func TestApi(t *testing.T) {
 	f.FormatF(buf, `%c`, ref)
 	assert.Equal(t, good, buf.String(), `testing for Formatter.FormatF`)
 }
+
+func TestParse(t *testing.T) {
+	ref := time.Unix(1136239445, 0).UTC()
+
+	// formatting then parsing should give back the original time
+	cmp := []struct {
+		L language.Tag
+		F string
+	}{
+		{language.English, `%c`},
+		{language.English, `%a, %d %b %Y %H:%M:%S %z`},
+		{language.English, `%D %r`},
+		{language.English, `%s`},
+		{language.AmericanEnglish, `%x %X`},
+		{language.French, `%A %d %B %Y %T`},
+		{language.French, `%c`},
+		{language.German, `%x %X`},
+		{language.Russian, `%c`},
+		{language.Korean, `%c`},
+		{language.Japanese, `%c`},
+		{language.SimplifiedChinese, `%c`},
+		{language.Thai, `%EX %A %d %B %Y`},
+		{language.Thai, `%c`},
+		{language.Thai, `%Ec`},
+		{language.Thai, `%D %T`},
+		{language.MustParse(`zh-TW`), `%Ec`},
+		{language.Japanese, `%Ec`},
+		{language.Japanese, `%EC%Ey年 %m/%d %T`},
+		{language.Japanese, `%Er %Ek %F %T`},
+		{language.English, `%Y%m%d%H%M%S`},
+		{language.English, `%Y%j%T`},
+	}
+
+	for _, x := range cmp {
+		f := strftime.New(x.L)
+		s := f.Format(x.F, ref)
+		res, err := f.Parse(x.F, s)
+		if assert.NoError(t, err, `parsing `+s+` as `+x.F) {
+			assert.Equal(t, ref, res, `parsing `+s+` as `+x.F)
+		}
+	}
+
+	values := []struct {
+		F, S string
+		T    time.Time
+	}{
+		{`%Y-%m-%dT%H:%M:%S.%f%z`, `2006-01-02T22:04:05.456841+0900`, time.Date(2006, 1, 2, 22, 4, 5, 456841000, time.FixedZone("", 9*3600))},
+		{`%Y-%m-%d %H:%M %z`, `2006-01-02 22:04 -03:30`, time.Date(2006, 1, 2, 22, 4, 0, 0, time.FixedZone("", -(3*3600+30*60)))},
+		{`%e %b %y`, `2 JAN 06`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%d/%m/%y`, `31/12/69`, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{`%C%y %j`, `2006 002`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%I:%M %p`, `12:30 am`, time.Date(0, 1, 1, 0, 30, 0, 0, time.UTC)},
+		{`%-d/%-m/%Y`, `2/1/2006`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%s`, `-86400`, time.Unix(-86400, 0).UTC()},
+		{`%H:%M:%S %Z`, `22:04:05 UTC`, time.Date(0, 1, 1, 22, 4, 5, 0, time.UTC)},
+		{`%Y%m%d`, `20060102`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%Y%j`, `2006002`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%Y`, `123456`, time.Date(123456, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{`%3N%S`, `12345`, time.Date(0, 1, 1, 0, 0, 45, 123000000, time.UTC)},
+		{`%6Y%3m%3d`, `002006001002`, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{`%_4H%-2M`, `  2204`, time.Date(0, 1, 1, 22, 4, 0, 0, time.UTC)},
+		{`%S.%12N`, `05.123456789012`, time.Date(0, 1, 1, 0, 0, 5, 123456789, time.UTC)},
+	}
+
+	for _, x := range values {
+		res, err := strftime.Parse(language.English, x.F, x.S)
+		if assert.NoError(t, err, `parsing `+x.S+` as `+x.F) {
+			assert.True(t, x.T.Equal(res), `parsing `+x.S+` as `+x.F+` gave `+res.String())
+			_, o1 := x.T.Zone()
+			_, o2 := res.Zone()
+			assert.Equal(t, o1, o2, `zone offset when parsing `+x.S+` as `+x.F)
+		}
+	}
+
+	loc := time.FixedZone("JST", 9*3600)
+	res, err := strftime.EnglishFormatter.ParseInLocation(`%Y-%m-%d %H:%M`, `2006-01-02 22:04`, loc)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2006, 1, 2, 22, 4, 0, 0, loc), res, `ParseInLocation`)
+
+	errs := []struct {
+		F, S   string
+		Offset int
+	}{
+		{`%Y-%m-%d`, `2006-13-02`, 5},
+		{`%Y-%m-%d`, `2006-02-30`, 10},
+		{`%Y-%m-%d`, `2006-01-02 extra`, 10},
+		{`%A`, `Moonday`, 0},
+		{`%H:%M`, `22h04`, 2},
+		{`%z`, `0900`, 0},
+	}
+
+	for _, x := range errs {
+		_, err := strftime.Parse(language.English, x.F, x.S)
+		var perr *strftime.ParseError
+		if assert.ErrorAs(t, err, &perr, `parsing `+x.S+` as `+x.F) {
+			assert.Equal(t, x.Offset, perr.Offset, `error offset when parsing `+x.S+` as `+x.F)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	formats := []string{
+		`%A %a %B %b %C %c %D %d %e %F %H %h %I %j %k %l %M %m %n %p %R %r %S %T %t %U %u %V %v %W %w %X %x %Y %y %Z %z`,
+		`%Ec %EC %Ex %EX %Ey %EY`,
+		`%Od %Om %OH:%OM:%OS %OV %OW %Ow`,
+		`%-d/%-m %-H:%-M:%-S %-I %-j %f %s %g %G`,
+		`%^c %_d %010Y %#p %-10A| %^x`,
+		`%_5Od %^EY %-3N %#Z %^OB %12Ec|`,
+		`%z %:z %::z %:::z %Ez %E:z`,
+		`%S.%3N %L %N %-N %6f`,
+		`%s %Q %:Q %::Q`,
+		`100%% complete with %%a and more`,
+		``,
+	}
+
+	for _, l := range []language.Tag{language.English, language.Japanese, language.French, language.Korean, language.Thai} {
+		f := strftime.New(l)
+		for _, x := range formats {
+			p, err := f.Compile(x)
+			if !assert.NoError(t, err, `compiling `+x) {
+				continue
+			}
+			good := f.Format(x, ref)
+			assert.Equal(t, good, p.Format(ref), `compiled format for `+x)
+			assert.Equal(t, append([]byte("Test: "), []byte(good)...), p.AppendFormat([]byte("Test: "), ref), `compiled AppendFormat for `+x)
+
+			buf := &bytes.Buffer{}
+			p.FormatF(buf, ref)
+			assert.Equal(t, good, buf.String(), `compiled FormatF for `+x)
+		}
+	}
+
+	assert.Equal(t, `Mon Jan  2 22:04:05 2006`, strftime.MustCompile(`%c`).Format(ref), `testing strftime.MustCompile`)
+
+	_, err := strftime.Compile(`Test %K`)
+	assert.Error(t, err, `compiling invalid format`)
+	assert.Panics(t, func() { strftime.MustCompile(`%`) }, `MustCompile with invalid format`)
+}
+
+func TestValidate(t *testing.T) {
+	cmp := []struct {
+		F      string
+		Offset int
+		Spec   string
+	}{
+		{`%c %Ex %Oy %-d %%`, -1, ``},
+		{`%`, 0, `%`},
+		{`Test % string`, 5, `% `},
+		{`Test %K string`, 5, `%K`},
+		{`Test %%% string`, 7, `% `},
+		{`Test %E`, 5, `%E`},
+		{`Test %Eq`, 5, `%Eq`},
+		{`Test %Oé`, 5, `%Oé`},
+		{`%Y-%-q`, 3, `%-q`},
+	}
+
+	f := strftime.New(language.English)
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	for _, x := range cmp {
+		err := strftime.Validate(x.F)
+		_, ferr := f.FormatStrict(x.F, ref)
+		_, aerr := f.AppendFormatStrict(nil, x.F, ref)
+		if x.Offset == -1 {
+			assert.NoError(t, err, `validating `+x.F)
+			assert.NoError(t, ferr, `strict formatting `+x.F)
+			assert.NoError(t, aerr, `strict appending `+x.F)
+			continue
+		}
+
+		var e *strftime.FormatError
+		if assert.ErrorAs(t, err, &e, `validating `+x.F) {
+			assert.Equal(t, x.Offset, e.Offset, `error offset for `+x.F)
+			assert.Equal(t, x.Spec, e.Spec, `error specifier for `+x.F)
+		}
+		assert.Equal(t, err, ferr, `strict formatting `+x.F)
+		assert.Equal(t, err, aerr, `strict appending `+x.F)
+	}
+}
+
+func TestValidateCalendar(t *testing.T) {
+	// era conversions are checked against the calendar of the Formatter's locale
+	cmp := []struct {
+		Tag   string
+		F     string
+		Valid bool
+	}{
+		{`en`, `%Ey %ED`, true},
+		{`zh-u-ca-chinese`, `%EY %ED %EZ %OED`, true},
+		{`ja`, `%EY %Er %Ek`, true},
+		{`ja`, `%ED`, false},
+		{`ja`, `%OEZ`, false},
+		{`he-u-ca-hebrew`, `%ES`, false},
+		{`th`, `%Ex %EZ`, false},
+	}
+
+	ref := time.Unix(1136239445, 456841962).UTC()
+	for _, x := range cmp {
+		f := strftime.New(language.MustParse(x.Tag))
+		_, err := f.FormatStrict(x.F, ref)
+		_, cerr := f.Compile(x.F)
+		if x.Valid {
+			assert.NoError(t, err, `strict formatting `+x.F+` in `+x.Tag)
+			assert.NoError(t, cerr, `compiling `+x.F+` in `+x.Tag)
+			continue
+		}
+		var e *strftime.FormatError
+		assert.ErrorAs(t, err, &e, `strict formatting `+x.F+` in `+x.Tag)
+		assert.ErrorAs(t, cerr, &e, `compiling `+x.F+` in `+x.Tag)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	// Swedish names under a private use tag (qaa), so that no built-in locale is changed
+	qaa := language.MustParse(`qaa`)
+	swedish := &strftime.Locale{
+		Tag:    qaa,
+		DTfmt:  "%a %e %b %Y %H:%M:%S",
+		Dfmt:   "%Y-%m-%d",
+		Tfmt:   "%H:%M:%S",
+		Tfmt12: "%I:%M:%S %p",
+		AmPm:   [2]string{"fm", "em"},
+
+		AbDay:   [7]string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"},
+		Day:     [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		AbMonth: [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		Month:   [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+	}
+
+	assert.Error(t, strftime.RegisterLocale(nil), `registering nil locale`)
+	assert.Error(t, strftime.RegisterLocale(&strftime.Locale{}), `registering locale without tag`)
+	assert.NoError(t, strftime.RegisterLocale(swedish), `registering Swedish`)
+
+	// changes after registration have no effect
+	swedish.Day[1] = "xxx"
+
+	assert.Equal(t, `måndag 2 januari 2006`, strftime.New(qaa).Format(`%A %-d %B %Y`, ref), `New after RegisterLocale`)
+	assert.Equal(t, `2006-01-02`, strftime.Format(qaa, `%x`, ref), `Format after RegisterLocale`)
+	assert.Equal(t, `mån  2 jan 2006 22:04:05`, strftime.New(language.MustParse(`qaa-FI`)).Format(`%c`, ref), `matching regional tag after RegisterLocale`)
+
+	// patching an existing locale, which is restored at the end of the test
+	dutch := strftime.LookupLocale(language.Dutch)
+	t.Cleanup(func() { strftime.RegisterLocale(dutch) })
+	nl := strftime.LookupLocale(language.Dutch)
+	nl.Dfmt = "%d-%m-%Y"
+	assert.NoError(t, strftime.RegisterLocale(nl), `patching Dutch`)
+	assert.Equal(t, `02-01-2006`, strftime.Format(language.Dutch, `%x`, ref), `Format after patching Dutch`)
+	nl.Dfmt = "%d-%m-%y"
+	assert.NoError(t, strftime.RegisterLocale(nl), `restoring Dutch`)
+	assert.Equal(t, `02-01-06`, strftime.Format(language.Dutch, `%x`, ref), `Format after restoring Dutch`)
+
+	// registration is safe for concurrent use
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			strftime.RegisterLocale(swedish)
+		}
+		close(done)
+	}()
+	for i := 0; i < 100; i++ {
+		strftime.Format(qaa, `%c`, ref)
+		strftime.New(qaa)
+	}
+	<-done
+}
+
+// TestThai tests the Thai locale and Buddhist Era years
+func TestThai(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	f := strftime.New(language.Thai)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%x`, `02/01/2549`, ref},
+		{`%D`, `02/01/49`, ref},
+		{`%Eg|%y`, `49|06`, ref},
+		{`%c`, `จ.  2 ม.ค. 2549, 22:04:05`, ref},
+		{`%Ec`, `วันจันทร์ที่  2 มกราคม พ.ศ. 2549, 22.04.05 น.`, ref},
+		{`%Ex`, ` 2 ม.ค. 2549`, ref},
+		{`%EC`, `พ.ศ.`, ref},
+		{`%Ey`, `2549`, ref},
+		{`%EY`, `พ.ศ. 2549`, ref},
+		{`%Y`, `2006`, ref},
+		{`%EY`, `พ.ศ. 2567`, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T.UTC()), `matching for `+x.A)
+	}
+}
+
+// TestMinguo tests the Republic of China calendar used by the Taiwanese locale
+func TestMinguo(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	tw := language.MustParse(`zh-TW`)
+	f := strftime.New(tw)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%Ex`, `民國95年01月02日`, ref},
+		{`%Ec`, `民國95年01月02日 (星期一) 22時04分05秒`, ref},
+		{`%x`, `2006年01月02日`, ref},
+		{`%EC %Ey`, `民國 95`, ref},
+		{`%EY`, `民國113年`, time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)},
+		{`%EY`, `民國元年`, time.Date(1912, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{`%EY`, `民國前1年`, time.Date(1911, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{`%EY`, `民國前12年`, time.Date(1900, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T.UTC()), `matching for `+x.A)
+	}
+
+	assert.Equal(t, `民國95年01月02日`, strftime.Format(tw, `%Ex`, ref), `Format with zh-TW`)
+
+	// other Traditional Chinese regions keep Gregorian years
+	assert.Equal(t, `2006年01月02日`, strftime.Format(language.MustParse(`zh-HK`), `%Ex`, ref), `Format with zh-HK`)
+	assert.Equal(t, `2006年01月02日`, strftime.New(language.TraditionalChinese).Format(`%Ex`, ref), `Traditional Chinese era format`)
+}
+
+// TestFlags tests GNU flags and field widths
+func TestFlags(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	f := strftime.New(language.English)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%-e`, `2`, ref},
+		{`%0e`, `02`, ref},
+		{`%_d`, ` 2`, ref},
+		{`%_m/%_H`, ` 1/22`, ref},
+		{`%-k %-l`, `22 10`, ref},
+		{`%_j`, `  2`, ref},
+		{`%-U %-V %-W %-y %-C`, `1 1 1 6 20`, ref},
+		{`%010Y`, `0000002006`, ref},
+		{`%_6Y`, `  2006`, ref},
+		{`%3d`, `002`, ref},
+		{`%-4H`, `22`, ref},
+		{`%05Y`, `-0044`, time.Date(-44, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{`%^a %^B`, `MON JANUARY`, ref},
+		{`%#a %#p %#Z`, `MON pm utc`, ref},
+		{`%^c`, `MON JAN  2 22:04:05 2006`, ref},
+		{`%10A|%-10A|%010A`, `    Monday|Monday|0000Monday`, ref},
+		{`%^12B|`, `     JANUARY|`, ref},
+		{`%-Ey %_EC`, `6 20`, ref},
+		{`%-Od`, `2`, ref},
+		{`%^-q`, `%^-q`, ref},
+		{`%_`, `%_`, ref},
+		{`%12`, `%12`, ref},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T), `matching for `+x.A)
+	}
+
+	// flags do not change non-decimal numerals
+	assert.Equal(t, `二`, strftime.New(language.Japanese).Format(`%-Od`, ref), `flags with %O`)
+
+	// padding is accepted when parsing
+	res, err := strftime.Parse(language.English, `%_d/%_m/%Y %^b %10A`, ` 2/ 1/2006 JAN     Monday`)
+	if assert.NoError(t, err, `parsing with flags`) {
+		assert.Equal(t, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), res, `parsing with flags`)
+	}
+
+	assert.NoError(t, strftime.Validate(`%_d %010Y %^a %#Z %-Ey`), `validating flags`)
+	err = strftime.Validate(`%Y %_5`)
+	var e *strftime.FormatError
+	if assert.ErrorAs(t, err, &e, `validating truncated flags`) {
+		assert.Equal(t, `truncated conversion specification`, e.Message)
+		assert.Equal(t, `%_5`, e.Spec)
+	}
+	err = strftime.Validate(`%^5K`)
+	if assert.ErrorAs(t, err, &e, `validating unknown conversion with flags`) {
+		assert.Equal(t, `unknown conversion specification`, e.Message)
+		assert.Equal(t, `%^5K`, e.Spec)
+	}
+}
+
+// TestOffset tests the time zone offset formats
+func TestOffset(t *testing.T) {
+	f := strftime.New(language.English)
+	ref := time.Unix(1136239445, 0)
+
+	cmp := []struct {
+		A, B string
+		Z    *time.Location
+	}{
+		{`%z %:z %::z %:::z`, `+0900 +09:00 +09:00:00 +09`, time.FixedZone("JST", 9*3600)},
+		{`%z %:z %::z %:::z`, `-0330 -03:30 -03:30:00 -03:30`, time.FixedZone("", -(3*3600 + 30*60))},
+		{`%z %:z %::z %:::z`, `+0000 +00:00 +00:00:00 +00`, time.UTC},
+		{`%z %:z %::z %:::z`, `+001930 +00:19:30 +00:19:30 +00:19:30`, time.FixedZone("LMT", 19*60+30)},
+		{`%Ez %E:z %E::z %E:::z`, `Z Z Z Z`, time.UTC},
+		{`%Ez %E:z %E::z %E:::z`, `+0530 +05:30 +05:30:00 +05:30`, time.FixedZone("IST", 5*3600+30*60)},
+		{`%Y-%m-%dT%H:%M:%S%E:z`, `2006-01-02T22:04:05Z`, time.UTC},
+		{`%::`, `%::`, time.UTC},
+		{`%::::z`, `%::::z`, time.UTC},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, ref.In(x.Z)), `matching for `+x.A)
+	}
+
+	values := []struct {
+		F, S string
+		Z    int
+	}{
+		{`%H:%M%:z`, `22:04+09:00`, 9 * 3600},
+		{`%H:%M%:::z`, `22:04-03`, -3 * 3600},
+		{`%H:%M %::z`, `22:04 +00:19:30`, 19*60 + 30},
+		{`%H:%M %z`, `22:04 +001930`, 19*60 + 30},
+		{`%H:%M%E:z`, `22:04Z`, 0},
+	}
+
+	for _, x := range values {
+		res, err := f.Parse(x.F, x.S)
+		if assert.NoError(t, err, `parsing `+x.S+` as `+x.F) {
+			_, z := res.Zone()
+			assert.Equal(t, x.Z, z, `zone offset when parsing `+x.S+` as `+x.F)
+		}
+	}
+
+	assert.NoError(t, strftime.Validate(`%z %:z %::z %:::z %Ez %E:::z %_:z`), `validating offsets`)
+	assert.Error(t, strftime.Validate(`%::::z`), `validating too many colons`)
+	assert.Error(t, strftime.Validate(`%:`), `validating truncated offset`)
+}
+
+// TestFraction tests fractional seconds with various precisions
+func TestFraction(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	f := strftime.New(language.English)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%f %N %L`, `456841 456841962 456`, ref},
+		{`%3N %6N %9N %1N %12N`, `456 456841 456841962 4 456841962000`, ref},
+		{`%3f %9f %6L`, `456 456841962 456841`, ref},
+		{`%S.%-N`, `05.456841962`, ref},
+		{`%S.%-N`, `05.5`, time.Unix(1136239445, 500000000).UTC()},
+		{`%S.%-N`, `05`, time.Unix(1136239445, 0).UTC()},
+		{`%S.%-3N`, `05`, time.Unix(1136239445, 999999).UTC()},
+		{`%S.%L`, `05.000`, time.Unix(1136239445, 999999).UTC()},
+		{`%T.%9N`, `22:04:05.000000001`, time.Unix(1136239445, 1).UTC()},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T), `matching for `+x.A)
+	}
+
+	r := f.WithRounding(true)
+	late := time.Date(2006, 12, 31, 23, 59, 59, 999600000, time.UTC)
+
+	rounded := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%T.%L`, `22:04:05.457`, ref},
+		{`%T.%L %N`, `22:04:05.456 456841962`, ref},
+		{`%S.%1N`, `05.5`, ref},
+		{`%F %T.%L`, `2007-01-01 00:00:00.000`, late},
+		{`%F %T`, `2006-12-31 23:59:59`, late},
+		{`%S.%-L`, `00`, late},
+	}
+
+	for _, x := range rounded {
+		assert.Equal(t, x.B, r.Format(x.A, x.T), `rounding for `+x.A)
+		assert.Equal(t, x.B, string(r.AppendFormat(nil, x.A, x.T)), `rounding with AppendFormat for `+x.A)
+		p, err := r.Compile(x.A)
+		if assert.NoError(t, err, `compiling `+x.A) {
+			assert.Equal(t, x.B, p.Format(x.T), `rounding with compiled `+x.A)
+		}
+	}
+
+	// the original formatter is not changed
+	assert.Equal(t, `2006-12-31 23:59:59.999`, f.Format(`%F %T.%L`, late), `truncating after WithRounding`)
+
+	res, err := f.Parse(`%T.%L`, `22:04:05.456`)
+	if assert.NoError(t, err, `parsing %L`) {
+		assert.Equal(t, 456000000, res.Nanosecond(), `parsing %L`)
+	}
+	res, err = f.Parse(`%T.%N`, `22:04:05.456841962`)
+	if assert.NoError(t, err, `parsing %N`) {
+		assert.Equal(t, 456841962, res.Nanosecond(), `parsing %N`)
+	}
+}
+
+// TestEpoch tests the Unix epoch conversions
+func TestEpoch(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	old := time.Unix(-1, 999000).UTC() // 1969-12-31 23:59:59.000999
+	f := strftime.New(language.English)
+
+	cmp := []struct {
+		A, B string
+		T    time.Time
+	}{
+		{`%s %Q %:Q %::Q`, `1136239445 1136239445456 1136239445456841 1136239445456841962`, ref},
+		{`%Q|%Y-%m-%d`, `1136239445456|2006-01-02`, ref},
+		{`%s %Q %:Q %::Q`, `-1 -1000 -999001 -999001000`, old},
+		{`%Q`, `-1`, time.Unix(0, -1).UTC()},
+		{`%Q %:Q`, `0 0`, time.Unix(0, 0).UTC()},
+		{`%015Q`, `001136239445456`, ref},
+		{`%:::Q`, `%:::Q`, ref},
+	}
+
+	for _, x := range cmp {
+		assert.Equal(t, x.B, f.Format(x.A, x.T), `matching for `+x.A)
+	}
+
+	assert.Equal(t, `1136239445457`, f.WithRounding(true).Format(`%Q`, ref), `rounding %Q`)
+
+	values := []struct {
+		F, S string
+		T    time.Time
+	}{
+		{`%Q`, `1136239445456`, time.Unix(1136239445, 456000000)},
+		{`%:Q`, `1136239445456841`, time.Unix(1136239445, 456841000)},
+		{`%::Q`, `1136239445456841962`, ref},
+		{`%Q`, `-1000`, time.Unix(-1, 0)},
+		{`%:Q`, `-999001`, time.Unix(-1, 999000)},
+	}
+
+	for _, x := range values {
+		res, err := f.Parse(x.F, x.S)
+		if assert.NoError(t, err, `parsing `+x.S+` as `+x.F) {
+			assert.True(t, x.T.Equal(res), `parsing `+x.S+` as `+x.F+` gave `+res.String())
+		}
+	}
+
+	assert.NoError(t, strftime.Validate(`%Q %:Q %::Q %-Q`), `validating epoch conversions`)
+	assert.Error(t, strftime.Validate(`%:::Q`), `validating %:::Q`)
+}
+
+// TestExtensions tests the Unicode extensions of language tags
+func TestExtensions(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	mid := time.Date(2006, 1, 2, 0, 4, 5, 0, time.UTC)
+	sun := time.Date(2006, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	cmp := []struct {
+		L, A, B string
+		T       time.Time
+	}{
+		{`en-GB`, `%c|%X`, `Mon 02 Jan 2006 22:04:05 UTC|22:04:05`, ref},
+		{`en-GB-u-hc-h12`, `%c|%X`, `Mon 02 Jan 2006 10:04:05 pm UTC|10:04:05 pm UTC`, ref},
+		{`en-u-hc-h12`, `%c|%X|%T|%r`, `Mon Jan  2 10:04:05 PM 2006|10:04:05 PM|22:04:05|10:04:05 PM`, ref},
+		{`en-u-hc-h11`, `%c|%I|%l`, `Mon Jan  2 00:04:05 AM 2006|00| 0`, mid},
+		{`en-u-hc-h24`, `%c|%H|%k`, `Mon Jan  2 24:04:05 2006|24|24`, mid},
+		{`en-u-hc-h23`, `%c|%H`, `Mon Jan  2 00:04:05 2006|00`, mid},
+		{`ko-u-hc-h23`, `%c`, `2006년 01월 02일 (월) 22시 04분 05초`, ref},
+		{`ja-u-hc-h12`, `%c|%Ec`, `2006年01月02日 午後10時04分05秒|平成18年01月02日 午後10時04分05秒`, ref},
+		{`zh-u-hc-h12`, `%X`, `下午 10时04分05秒`, ref},
+		{`de-DE`, `%U %W`, `02 01`, sun},
+		{`de-DE-u-fw-sun`, `%U %W %V`, `02 02 01`, sun},
+		{`de-DE-u-fw-mon`, `%U %W %V`, `02 01 01`, sun},
+		{`de-DE-u-fw-sat`, `%U %W`, `02 01`, sun},
+		{`en`, `%W %U`, `02 01`, time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{`en-u-fw-sun`, `%W %U|%-W`, `01 01|1`, time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{`en-u-fw-wed`, `%W`, `01`, time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC)},
+		{`ar-u-nu-arab`, `%Od %Oe|%OH|%-Od|%d`, `٠٢  ٢|٢٢|٢|02`, ref},
+		{`en-u-nu-deva`, `%Om/%Od`, `०१/०२`, ref},
+		{`ja-u-nu-latn`, `%Od`, `02`, ref},
+		{`en-u-nu-jpan`, `%Od`, `二`, ref},
+		{`en-u-ca-japanese`, `%EY`, `平成18年`, ref},
+		{`ja-u-ca-gregory`, `%EY|%Ec`, `2006|2006年01月02日 22時04分05秒`, ref},
+		{`en-u-ca-buddhist`, `%EY|%EC|%Ex`, `2549 BE|BE|01/02/2549`, ref},
+		{`en-u-ca-roc`, `%EY|%Ex`, `95 Minguo|01/02/95`, ref},
+		{`en-u-ca-roc`, `%EY`, `5 B.R.O.C.`, time.Date(1907, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-persian`, `%Ex|%Ec`, `10/12/1384|Mon Dey 12 22:04:05 1384 AP`, ref},
+		{`zh-TW-u-ca-gregory`, `%Ex`, `2006年01月02日`, ref},
+		{`en-u-tz-utc`, `%c %Z`, `Mon Jan  2 22:04:05 2006 UTC`, ref},
+		{`en-u-nu-unknown-ca-unknown-tz-unknown`, `%Od %EY %Z`, `02 2006 UTC`, ref},
+	}
+
+	for _, x := range cmp {
+		tag := language.MustParse(x.L)
+		assert.Equal(t, x.B, strftime.New(tag).Format(x.A, x.T), `matching for `+x.A+` in `+x.L)
+		assert.Equal(t, x.B, strftime.Format(tag, x.A, x.T), `Format for `+x.A+` in `+x.L)
+		p, err := strftime.New(tag).Compile(x.A)
+		if assert.NoError(t, err, `compiling `+x.A) {
+			assert.Equal(t, x.B, p.Format(x.T), `compiled `+x.A+` in `+x.L)
+		}
+	}
+
+	// unsupported values are ignored by New, and reported by CheckExtensions
+	assert.NoError(t, strftime.CheckExtensions(language.MustParse(`en-u-hc-h12-ca-japanese-nu-arab-tz-utc-fw-sun`)), `supported extensions`)
+	err := strftime.CheckExtensions(language.MustParse(`en-u-ca-unknown-fw-xyz-hc-h12-tz-zzzzz`))
+	if assert.Error(t, err, `unsupported extensions`) {
+		assert.Equal(t, `strftime: unsupported Unicode extension values ca-unknown, tz-zzzzz, fw-xyz in en-u-ca-unknown-fw-xyz-hc-h12-tz-zzzzz`, err.Error(), `unsupported extensions`)
+	}
+
+	// extensions apply to the matched tag only
+	assert.Equal(t, `22:04:05`, strftime.New(language.French, language.MustParse(`en-u-hc-h12`)).Format(`%X`, ref), `extensions of other tags`)
+
+	// parsing uses the hour cycle
+	res, err := strftime.New(language.MustParse(`en-u-hc-h24`)).Parse(`%H:%M`, `24:04`)
+	if assert.NoError(t, err, `parsing with h24`) {
+		assert.Equal(t, 0, res.Hour(), `parsing with h24`)
+	}
+
+	if _, err := time.LoadLocation("Asia/Tokyo"); err != nil {
+		t.Skip("time zone database not available")
+	}
+	tag := language.MustParse(`en-u-tz-jptyo`)
+	f := strftime.New(tag)
+	assert.Equal(t, `Tue Jan  3 07:04:05 2006 JST +0900`, f.Format(`%c %Z %z`, ref), `tz extension`)
+	res, err = f.Parse(`%F %T`, `2006-01-03 07:04:05`)
+	if assert.NoError(t, err, `parsing with tz`) {
+		assert.True(t, ref.Truncate(time.Second).Equal(res), `parsing with tz gave `+res.String())
+	}
+}
+
+// TestHourCycleFormats checks the conversion of locale formats for the hc extension
+func TestHourCycleFormats(t *testing.T) {
+	twelve := []struct {
+		f, tfmt12, expected string
+	}{
+		{`%a %d %b %Y %T`, `%I:%M:%S %p`, `%a %d %b %Y %I:%M:%S %p`},
+		{`%-H:%M`, `%I:%M %p`, `%-I:%M %p`},
+		{`%_H.%M Uhr`, `%I:%M %p`, `%_I.%M %p Uhr`},
+		{`%%H %H:%M`, `%I:%M %p`, `%%H %I:%M %p`},
+		{`%H時%M分%S秒`, `%p%I時%M分%S秒`, `%p%I時%M分%S秒`},
+		{`%H:%M`, `%p %l:%M`, `%p %I:%M`},
+		{`Il est %kh%M`, `%l:%M %P`, `Il est %lh%M %P`},
+		{`%d/%m/%Y`, `%I:%M %p`, `%d/%m/%Y`},
+	}
+	for _, x := range twelve {
+		assert.Equal(t, x.expected, strftime.TwelveHourFormat(x.f, x.tfmt12), `12-hour version of `+x.f)
+	}
+
+	twentyFour := []struct {
+		f, r24, expected string
+	}{
+		{`%a %d %b %Y %r`, `%X`, `%a %d %b %Y %X`},
+		{`%-I:%M %p`, `%T`, `%-H:%M`},
+		{`%p %l:%M`, `%T`, `%k:%M`},
+		{`%%I %I:%M %P`, `%T`, `%%I %H:%M`},
+		{`Il est %H:%M`, `%T`, `Il est %H:%M`},
+		{`%EY %Ec`, `%T`, `%EY %Ec`},
+	}
+	for _, x := range twentyFour {
+		assert.Equal(t, x.expected, strftime.TwentyFourHourFormat(x.f, x.r24), `24-hour version of `+x.f)
+	}
+}
+
+// TestLocaleComposites tests the locale-specific expansions of %D, %r and %R
+func TestLocaleComposites(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	cmp := []struct {
+		L    language.Tag
+		A, B string
+	}{
+		{language.English, `%D|%r|%R`, `01/02/06|10:04:05 PM|22:04`},
+		{language.BritishEnglish, `%D|%r`, `02/01/06|10:04:05 pm UTC`},
+		{language.Korean, `%D|%r|%R`, `06. 01. 02.|오후 10시 04분 05초|22시 04분`},
+		{language.Korean, `%c`, `2006년 01월 02일 (월) 오후 10시 04분 05초`},
+		{language.Japanese, `%D|%r|%R`, `06/01/02|午後10時04分05秒|22時04分`},
+		{language.SimplifiedChinese, `%D|%r|%R`, `06/01/02|下午 10时04分05秒|22时04分`},
+		{language.German, `%D|%R`, `02.01.06|22:04`},
+		{language.Thai, `%D|%r`, `02/01/49|10:04:05 PM`},
+	}
+
+	for _, x := range cmp {
+		f := strftime.New(x.L)
+		assert.Equal(t, x.B, f.Format(x.A, ref), `matching for `+x.A+` in `+x.L.String())
+	}
+
+	// POSIX expansions
+	f := strftime.New(language.Korean).WithPOSIX(true)
+	assert.Equal(t, `01/02/06|10:04:05 오후|22:04`, f.Format(`%D|%r|%R`, ref), `POSIX expansions`)
+	p, err := f.Compile(`%D|%r|%R`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `01/02/06|10:04:05 오후|22:04`, p.Format(ref), `compiled POSIX expansions`)
+	}
+	assert.Equal(t, `06. 01. 02.`, strftime.New(language.Korean).Format(`%D`, ref), `WithPOSIX returns a copy`)
+
+	// parsing uses the same expansions
+	res, err := strftime.New(language.Korean).Parse(`%D %r`, `06. 01. 02. 오후 10시 04분 05초`)
+	if assert.NoError(t, err, `parsing Korean %D %r`) {
+		assert.Equal(t, ref.Truncate(time.Second), res, `parsing Korean %D %r`)
+	}
+}
+
+// TestInheritance tests the inheritance of locale fields from parent locales
+func TestInheritance(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	// a regional locale only needs the fields that differ from its parent; tags with the
+	// phonetic fonipa variant are used so that no built-in locale is changed
+	tag := language.MustParse(`es-419-fonipa`)
+	co := &strftime.Locale{
+		Tag:  tag,
+		Dfmt: "%d/%m/%Y",
+	}
+	assert.NoError(t, strftime.CheckLocale(co), `checking es-419-fonipa`)
+	assert.NoError(t, strftime.RegisterLocale(co), `registering es-419-fonipa`)
+	f := strftime.New(tag)
+	assert.Equal(t, `lunes 02/01/2006 10:04:05 p.m.`, f.Format(`%A %x %r`, ref), `es-419-fonipa inherits from es-419 and es`)
+	assert.Equal(t, `lunes`, strftime.LookupLocale(tag).Day[1], `LookupLocale returns the resolved locale`)
+	assert.Equal(t, `lunes 02/01/06`, strftime.New(language.Spanish).Format(`%A %x`, ref), `es is not changed`)
+
+	// incomplete locales are rejected, qab is a private use language without built-in locale
+	private := language.MustParse(`qab`)
+	incomplete := &strftime.Locale{
+		Tag:   private,
+		DTfmt: "%c",
+		Dfmt:  "%d/%m/%Y",
+		Tfmt:  "%H:%M:%S %q",
+		Day:   [7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+	}
+	err := strftime.CheckLocale(incomplete)
+	var e *strftime.LocaleError
+	if assert.ErrorAs(t, err, &e, `checking incomplete locale`) {
+		assert.Equal(t, private, e.Tag)
+		assert.Contains(t, e.Missing, `Tfmt12`)
+		assert.Contains(t, e.Missing, `AmPm[0]`)
+		assert.Contains(t, e.Missing, `AbDay[0]`)
+		assert.Contains(t, e.Missing, `Month[11]`)
+		assert.NotContains(t, e.Missing, `Day[0]`)
+		var ferr *strftime.FormatError
+		assert.ErrorAs(t, err, &ferr, `invalid format in locale`)
+	}
+	assert.Error(t, strftime.RegisterLocale(incomplete), `registering incomplete locale`)
+	assert.Equal(t, `Monday`, strftime.New(private).Format(`%A`, ref), `incomplete locale is not registered`)
+}
+
+// TestCompositeCycle tests that locales whose composite formats refer to themselves are
+// rejected instead of expanding forever
+func TestCompositeCycle(t *testing.T) {
+	tag := language.MustParse(`en-ZA`)
+	for _, l := range []*strftime.Locale{
+		{Tag: tag, DTfmt: `%a %c`},
+		{Tag: tag, Dfmt: `%-x`},
+		{Tag: tag, Tfmt: `%X`},
+		{Tag: tag, Dfmt: `%D`, DfmtShort: `%x`},
+		{Tag: tag, Tfmt12: `%R`, TfmtShort: `%r`},
+		{Tag: tag, DfmtEra: `%Ex`},
+	} {
+		err := strftime.CheckLocale(l)
+		var ferr *strftime.FormatError
+		if assert.ErrorAs(t, err, &ferr, `checking cyclic locale`) {
+			assert.Equal(t, `composite conversion refers to itself`, ferr.Message)
+		}
+		assert.Error(t, strftime.RegisterLocale(l), `registering cyclic locale`)
+	}
+
+	// composite conversions can still refer to each other
+	assert.NoError(t, strftime.CheckLocale(&strftime.Locale{Tag: tag, DTfmt: `%x %X`, Dfmt: `%d %%c`}))
+}
+
+// TestRegional tests regional locale variants and their matching
+func TestRegional(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	tests := []struct {
+		tag      string
+		format   string
+		expected string
+	}{
+		{`en-GB`, `%x`, `02/01/06`},
+		{`en-CA`, `%x|%r`, `2006-01-02|10:04:05 p.m.`},
+		{`en-AU`, `%x|%r|%B`, `02/01/2006|10:04:05 pm|January`},
+		{`en-IN`, `%x|%c`, `02/01/06|Mon 02 Jan 2006 22:04:05`},
+		{`en-NZ`, `%x`, `2/01/2006`},
+		{`en-ZA`, `%x`, `2006/01/02`},
+		{`fr-CA`, `%x|%A`, `2006-01-02|lundi`},
+		{`fr-CH`, `%x`, `02.01.2006`},
+		{`de-AT`, `%x|%B %b`, `02.01.2006|Jänner Jän`},
+		{`de-CH`, `%x|%B`, `02.01.2006|Januar`},
+		{`pt-BR`, `%x`, `02/01/2006`},
+		{`pt-PT`, `%x`, `02/01/2006`},
+		{`es-MX`, `%x|%r`, `02/01/2006|10:04:05 p.m.`},
+		{`es-ES`, `%x|%r`, `02/01/06|10:04:05 p. m.`},
+		{`nl-BE`, `%x`, `02/01/2006`},
+		{`it-CH`, `%x`, `02.01.2006`},
+		{`zh-HK`, `%x|%D`, `2006年01月02日|02/01/06`},
+		{`zh-Hant-HK`, `%D`, `02/01/06`},
+		{`zh-Hant-TW`, `%Ex`, `民國95年01月02日`},
+		{`de-CH-u-hc-h12`, `%X`, `10:04:05 PM`},
+	}
+
+	for _, tc := range tests {
+		tag := language.MustParse(tc.tag)
+		assert.Equal(t, tc.expected, strftime.New(tag).Format(tc.format, ref), `New with `+tc.tag)
+		assert.Equal(t, tc.expected, strftime.Format(tag, tc.format, ref), `Format with `+tc.tag)
+	}
+}
+
+// TestMonthForms tests the month names used in dates (%B, %b) and standalone (%OB, %Ob)
+func TestMonthForms(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	may := time.Date(2024, time.May, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag      language.Tag
+		format   string
+		expected string
+		t        time.Time
+	}{
+		{language.Russian, `%-d %B %Y|%OB|%b %Ob`, `5 мая 2024|Май|мая май`, may},
+		{language.Polish, `%-d %B %Y|%OB`, `2 stycznia 2006|styczeń`, ref},
+		{language.Ukrainian, `%-d %B %Y|%OB`, `2 січня 2006|січень`, ref},
+		{language.Czech, `%-d. %B %Y|%OB`, `2. ledna 2006|leden`, ref},
+		{language.Greek, `%-d %B %Y|%OB|%Ob`, `5 Μαΐου 2024|Μάιος|Μάι`, may},
+		{language.Lithuanian, `%c|%OB`, `2006 m. sausio 02 d. 22:04:05|sausis`, ref},
+		{language.Catalan, `%-d %B %Y|%OB|%Ob`, `2 de gener 2006|gener|gen.`, ref},
+		{language.Catalan, `%-d %B`, `5 de maig`, may},
+		{language.English, `%B|%OB|%Ob|%Oh`, `January|January|Jan|Jan`, ref},
+		{language.French, `%OB`, `janvier`, ref},
+		{language.Russian, `%^OB|%10Ob`, `ЯНВАРЬ|       янв`, ref},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, strftime.New(tc.tag).Format(tc.format, tc.t), tc.tag.String()+` `+tc.format)
+	}
+
+	// both forms are accepted when parsing
+	f := strftime.New(language.Russian)
+	for _, s := range []string{`02 января 2006`, `02 Январь 2006`, `02 янв 2006`} {
+		res, err := f.Parse(`%d %B %Y`, s)
+		if assert.NoError(t, err, `parsing `+s) {
+			assert.Equal(t, time.January, res.Month(), `parsing `+s)
+		}
+	}
+	res, err := f.Parse(`%d %OB %Y`, `05 Май 2024`)
+	if assert.NoError(t, err, `parsing %OB`) {
+		assert.Equal(t, may, res, `parsing %OB`)
+	}
+}
+
+// TestNumberingSystems tests the numbering systems used by %O conversions
+func TestNumberingSystems(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	tests := []struct {
+		f        *strftime.Formatter
+		format   string
+		expected string
+	}{
+		{strftime.New(language.English).WithNumberingSystem(`arab`), `%Od/%Om/%OY %Oj`, `٠٢/٠١/٢٠٠٦ ٠٠٢`},
+		{strftime.New(language.English).WithNumberingSystem(`arabext`), `%OY`, `۲۰۰۶`},
+		{strftime.New(language.English).WithNumberingSystem(`deva`), `%OH:%OM|%_Om|%-Oj`, `२२:०४| १|२`},
+		{strftime.New(language.English).WithNumberingSystem(`beng`), `%OY`, `২০০৬`},
+		{strftime.New(language.English).WithNumberingSystem(`fullwide`), `%OY年%Om月`, `２００６年０１月`},
+		{strftime.New(language.English).WithNumberingSystem(`hanidec`), `%OY`, `二〇〇六`},
+		{strftime.New(language.English).WithNumberingSystem(`jpan`), `%OY|%Oj`, `二千六|二`},
+		{strftime.New(language.English).WithNumberingSystem(`unknown`), `%OY`, `2006`},
+		{strftime.New(language.Thai), `%Od/%Om|%d`, `๐๒/๐๑|02`},
+		{strftime.New(language.Thai).WithNumberingSystem(`latn`), `%Od`, `02`},
+		{strftime.New(language.Japanese), `%OY|%OC|%Ou`, `二千六|二十|一`},
+		{strftime.New(language.Japanese).WithNumberingSystem(`latn`), `%OY`, `2006`},
+		{strftime.New(language.MustParse(`ja-u-nu-fullwide`)), `%Od`, `０２`},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, tc.f.Format(tc.format, ref), `formatting `+tc.format)
+	}
+
+	// custom numbering systems
+	assert.Error(t, strftime.RegisterNumberingSystem(&strftime.NumberingSystem{Name: `roman`}), `numbering system without digits`)
+	assert.NoError(t, strftime.RegisterNumberingSystem(&strftime.NumberingSystem{
+		Name:   `dots`,
+		Format: func(b []byte, v int) []byte { return append(b, strings.Repeat(`.`, v)...) },
+	}))
+	assert.Equal(t, `..`, strftime.New(language.English).WithNumberingSystem(`dots`).Format(`%Od`, ref), `custom numbering system`)
+	assert.Equal(t, `١`, strftime.LookupNumberingSystem(`arab`).Digits[1], `LookupNumberingSystem`)
+	assert.Nil(t, strftime.LookupNumberingSystem(`unknown`), `LookupNumberingSystem with unknown name`)
+
+	// decimal numbering systems can be parsed back
+	f := strftime.New(language.English).WithNumberingSystem(`arab`)
+	res, err := f.Parse(`%Od/%Om/%OY %OH:%OM:%OS`, f.Format(`%Od/%Om/%OY %OH:%OM:%OS`, ref))
+	if assert.NoError(t, err, `parsing Arabic-Indic digits`) {
+		assert.Equal(t, ref.Truncate(time.Second), res, `parsing Arabic-Indic digits`)
+	}
+	_, err = f.Parse(`%Od/%Om`, `٠٢/١٣`)
+	var e *strftime.ParseError
+	if assert.ErrorAs(t, err, &e, `parsing invalid month`) {
+		assert.Equal(t, len(`٠٢/`), e.Offset, `error offset in the original input`)
+	}
+}
+
+// TestChineseNumerals tests the Chinese numerals used by %O in Chinese locales
+func TestChineseNumerals(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+	dec := time.Date(2024, time.December, 3, 14, 10, 0, 0, time.UTC)
+	nov := time.Date(2010, time.November, 30, 0, 0, 0, 0, time.UTC)
+
+	hans := strftime.New(language.SimplifiedChinese)
+	hant := strftime.New(language.TraditionalChinese)
+
+	tests := []struct {
+		f        *strftime.Formatter
+		format   string
+		t        time.Time
+		expected string
+	}{
+		{hans, `%OY年%Om月%Od日`, dec, `二〇二四年十二月三日`},
+		{hans, `%OY年%Om月%Od日`, nov, `二〇一〇年十一月三十日`},
+		{hans, `%OH时%OM分%OS秒`, dec, `十四时十分零秒`},
+		{hans, `%Oy|%Oj|%OC`, ref, `〇六|二|二十`},
+		{hans, `%Oj`, dec, `三百三十八`},
+		{hans, `%Os`, ref, `十一亿三千六百二十三万九千四百四十五`},
+		{hant, `%OY年%Om月%Od日`, dec, `二〇二四年十二月三日`},
+		{hant, `%Os`, ref, `十一億三千六百二十三萬九千四百四十五`},
+		{strftime.New(language.MustParse(`zh-TW`)), `%Om月%Od日`, dec, `十二月三日`},
+		{strftime.New(language.MustParse(`zh-HK`)), `%Om月%Od日`, dec, `十二月三日`},
+		{strftime.New(language.MustParse(`zh-u-nu-latn`)), `%Om月%Od日`, dec, `12月03日`},
+		{strftime.New(language.English).WithNumberingSystem(`hans`), `%Od %OY`, ref, `二 二〇〇六`},
+		{hans, `%m月%d日`, dec, `12月03日`},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, tc.f.Format(tc.format, tc.t), `formatting `+tc.format)
+	}
+
+	// positional numbers, with 零 for skipped positions
+	numbers := []struct {
+		v        int
+		expected string
+	}{
+		{0, `零`}, {10, `十`}, {11, `十一`}, {20, `二十`}, {105, `一百零五`}, {110, `一百一十`},
+		{1001, `一千零一`}, {1010, `一千零一十`}, {10000, `一万`}, {100010, `十万零一十`},
+		{2000000, `二百万`}, {100000001, `一亿零一`},
+	}
+	ns := strftime.LookupNumberingSystem(`hans`)
+	for _, x := range numbers {
+		assert.Equal(t, x.expected, string(ns.Format(nil, x.v)), `formatting number`)
+	}
+
+	// years are decimal and can be parsed back, positional numbers cannot
+	res, err := hans.Parse(`%OY年%m月%d日`, `二〇二四年12月03日`)
+	if assert.NoError(t, err, `parsing Chinese year`) {
+		assert.Equal(t, dec.Truncate(24*time.Hour), res, `parsing Chinese year`)
+	}
+	_, err = hans.Parse(`%Om月`, `十二月`)
+	assert.Error(t, err, `parsing Chinese positional numbers`)
+}
+
+// testCalendar is a calendar with 13 months of 28 days, starting on January 1st 2000.
+type testCalendar struct{}
+
+func (testCalendar) Date(t time.Time) strftime.CalendarDate {
+	days := int(t.Sub(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+	return strftime.CalendarDate{Time: t, Year: days/364 + 1, Month: days%364/28 + 1, Day: days%28 + 1}
+}
+
+func (testCalendar) AppendEra(b []byte, tag language.Tag, d strftime.CalendarDate, c byte) ([]byte, bool) {
+	switch c {
+	case 'C':
+		return append(b, "TE"...), true
+	case 'y', 'Y':
+		return append(b, fmt.Sprintf("TE %d", d.Year)...), true
+	}
+	return b, false
+}
+
+func (testCalendar) MonthName(tag language.Tag, d strftime.CalendarDate, abbrev bool) string {
+	if d.Month == 13 {
+		return "Sol"
+	}
+	return ""
+}
+
+// TestCalendar tests the calendars used by the E modifier
+func TestCalendar(t *testing.T) {
+	ref := time.Unix(1136239445, 456841962).UTC()
+
+	tests := []struct {
+		f        *strftime.Formatter
+		format   string
+		expected string
+	}{
+		{strftime.New(language.English), `%Ed/%Em %Ee|%EB %Eb|%EC %Ey %EY`, `02/01  2|January Jan|20 06 2006`},
+		{strftime.New(language.English), `%-Ed|%^EB|%10Eb`, `2|JANUARY|       Jan`},
+		{strftime.New(language.Thai), `%Ed %EB %EY|%EC %Ey %Er`, `02 มกราคม พ.ศ. 2549|พ.ศ. 2549 2549`},
+		{strftime.New(language.Japanese), `%EY%Em月%Ed日|%Er|%Ek`, `平成18年01月02日|H18|平18`},
+		{strftime.New(language.MustParse(`zh-TW`)), `%EC%Ey年`, `民國95年`},
+		{strftime.New(language.English).WithCalendar(`japanese`), `%EY|%Ex`, `平成18年|01/02/18`},
+		{strftime.New(language.Thai).WithCalendar(`gregory`), `%EY|%Ex|%D`, `2006|02/01/06|02/01/06`},
+		{strftime.New(language.Thai).WithCalendar(`unknown`), `%EY`, `พ.ศ. 2549`},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, tc.f.Format(tc.format, ref), `formatting `+tc.format)
+	}
+
+	// custom calendars
+	assert.Error(t, strftime.RegisterCalendar(``, testCalendar{}), `calendar without a name`)
+	assert.NoError(t, strftime.RegisterCalendar(`test`, testCalendar{}), `registering calendar`)
+	f := strftime.New(language.MustParse(`en-u-ca-test`))
+	assert.Equal(t, `TE 8|03 Jan 01|January 03`, f.Format(`%EY|%Ed %Eb %Em|%EB %Ed`, time.Date(2006, 12, 25, 0, 0, 0, 0, time.UTC)), `custom calendar`)
+	assert.Equal(t, `TE 8|05 Sol 13|Sol 05`, f.Format(`%EY|%Ed %Eb %Em|%EB %Ed`, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 2888)), `custom calendar month name`)
+	assert.Equal(t, `%EQ`, f.Format(`%EQ`, ref), `conversion not supported by the calendar`)
+
+	// locales defined with the deprecated Eyear function keep working
+	loc := strftime.LookupLocale(language.English)
+	loc.Tag = language.MustParse(`en-150`)
+	loc.Calendar = nil
+	loc.Eyear = func(t time.Time, c byte) string {
+		if c == 'C' {
+			return `AD`
+		}
+		return `%E` + string(c)
+	}
+	assert.NoError(t, strftime.RegisterLocale(loc))
+	f = strftime.New(language.MustParse(`en-150`))
+	assert.Equal(t, `AD|%Ey|02`, f.Format(`%EC|%Ey|%Ed`, ref), `Eyear function`)
+
+	// calendar dates can only be parsed in calendars with Gregorian months and days, and
+	// era years in those that count Gregorian years from another epoch
+	res, err := strftime.New(language.English).Parse(`%Ed %EB %EY`, `02 January 2006`)
+	if assert.NoError(t, err, `parsing Gregorian calendar date`) {
+		assert.Equal(t, ref.Truncate(24*time.Hour), res)
+	}
+	res, err = strftime.New(language.Thai).Parse(`%Ed %EB %EY`, `02 มกราคม พ.ศ. 2549`)
+	if assert.NoError(t, err, `parsing Buddhist calendar date`) {
+		assert.Equal(t, ref.Truncate(24*time.Hour), res)
+	}
+	res, err = strftime.New(language.MustParse(`zh-TW`)).Parse(`%EY%m月%d日`, `民國前5年05月01日`)
+	if assert.NoError(t, err, `parsing Minguo calendar date before 1912`) {
+		assert.Equal(t, time.Date(1907, 5, 1, 0, 0, 0, 0, time.UTC), res)
+	}
+	res, err = strftime.New(language.MustParse(`en-u-ca-roc`)).Parse(`%m/%d %EY`, `05/01 5 B.R.O.C.`)
+	if assert.NoError(t, err, `parsing English Minguo calendar date before 1912`) {
+		assert.Equal(t, time.Date(1907, 5, 1, 0, 0, 0, 0, time.UTC), res)
+	}
+	res, err = strftime.New(language.MustParse(`en-u-ca-buddhist`)).Parse(`%Ex`, `01/02/2549`)
+	if assert.NoError(t, err, `parsing English Buddhist calendar date`) {
+		assert.Equal(t, ref.Truncate(24*time.Hour), res)
+	}
+	res, err = strftime.New(language.Japanese).Parse(`%EY%m月%d日`, `平成18年01月02日`)
+	if assert.NoError(t, err, `parsing Japanese era year`) {
+		assert.Equal(t, ref.Truncate(24*time.Hour), res)
+	}
+	res, err = strftime.New(language.Japanese).Parse(`%EY`, `令和元年`)
+	if assert.NoError(t, err, `parsing first Japanese era year`) {
+		assert.Equal(t, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), res)
+	}
+	_, err = strftime.New(language.MustParse(`ar-SA`)).Parse(`%Ed %EB`, `15 رمضان`)
+	assert.Error(t, err, `parsing Hijri calendar date`)
+}
+
+func TestHijri(t *testing.T) {
+	ramadan := time.Date(2024, 3, 25, 22, 4, 5, 0, time.UTC) // 15 Ramadan 1445
+	eid := time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC)      // 1 Shawwal 1444 in Saudi Arabia
+
+	tests := []struct {
+		tag      string
+		format   string
+		expected string
+		t        time.Time
+	}{
+		{`ar-SA`, `%Ex`, `١٥ رمضان ١٤٤٥ هـ`, ramadan},
+		{`ar-SA`, `%x|%EY|%EC|%Ey`, `25/03/2024|1445 هـ|هـ|1445`, ramadan},
+		{`ar-SA`, `%Ec`, `الاثنين ١٥ رمضان ١٤٤٥ هـ، ٢٢:٠٤:٠٥`, ramadan},
+		{`ar-SA`, `%OEd|%-OEd|%OEm`, `٠٢|٢|٠٩`, time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{`ar-u-ca-islamic`, `%Ex`, `١٥ رمضان ١٤٤٥ هـ`, ramadan},
+		{`ar`, `%Ex|%x`, `٢٥ مارس ٢٠٢٤|25/03/2024`, ramadan},
+		{`ar-SA-u-ca-gregory`, `%Ex`, `25/03/2024`, ramadan},
+		{`en-u-ca-islamic-umalqura`, `%Ed %EB %EY|%Eb %EC`, `01 Shawwal 1444 AH|Shaw. AH`, eid},
+		{`en-u-ca-islamic-civil`, `%Ed %EB %EY|%Eb %EC`, `30 Ramadan 1444 AH|Ram. AH`, eid},
+		{`en-u-ca-islamic-tbla`, `%Ed %EB %EY`, `01 Shawwal 1444 AH`, eid},
+		{`en-u-ca-islamic-civil`, `%Ed %EB %EY`, `01 Muharram 1 AH`, time.Date(622, 7, 19, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-islamic-civil`, `%Ed %EB %EY`, `29 Dhuʻl-Hijjah 1 BH`, time.Date(622, 7, 18, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-islamic-umalqura`, `%Ed %EB %EY`, `28 Shaʻban 1214 AH`, time.Date(1800, 1, 25, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-islamic-umalqura`, `%Ed %EB %EY`, `01 Muharram 1357 AH`, time.Date(1938, 3, 2, 0, 0, 0, 0, time.UTC)}, // published table
+		{`en-u-ca-islamic-umalqura`, `%Ed %EB %EY`, `30 Dhuʻl-Hijjah 1500 AH`, time.Date(2077, 11, 16, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-islamic-umalqura`, `%Ed %EB %EY`, `01 Muharram 1501 AH`, time.Date(2077, 11, 17, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		f := strftime.New(language.MustParse(tc.tag))
+		assert.Equal(t, tc.expected, f.Format(tc.format, tc.t), tc.tag+` `+tc.format)
+	}
+	assert.Equal(t, `1445 AH`, strftime.New(language.English).WithCalendar(`islamic`).Format(`%EY`, ramadan))
+
+	// Umm al-Qura months have 29 or 30 days
+	f := strftime.New(language.MustParse(`en-u-ca-islamic-umalqura`))
+	day := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	prev := 0
+	for i := 0; i < 36500; i++ {
+		d := day.AddDate(0, 0, i)
+		if f.Format(`%Ed`, d) == `01` {
+			if prev != 0 && i-prev != 29 && i-prev != 30 {
+				t.Errorf(`month of %d days ending on %s`, i-prev, d)
+			}
+			prev = i
+		}
+	}
+
+	// era formats can be parsed with the Gregorian calendar only
+	res, err := strftime.New(language.Arabic).Parse(`%Ex`, `٢٥ مارس ٢٠٢٤`)
+	if assert.NoError(t, err, `parsing Arabic date`) {
+		assert.Equal(t, ramadan.Truncate(24*time.Hour), res)
+	}
+	_, err = strftime.New(language.MustParse(`ar-SA`)).Parse(`%Ex`, `١٥ رمضان ١٤٤٥ هـ`)
+	assert.Error(t, err, `parsing Hijri date`)
+}
+
+func TestPersian(t *testing.T) {
+	ref := time.Unix(1136239445, 0).UTC() // 12 Dey 1384
+
+	tests := []struct {
+		tag      string
+		format   string
+		expected string
+		t        time.Time
+	}{
+		{`fa`, `%Ex`, `۱۴۰۳/۰۷/۲۵`, time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{`fa`, `%Ec`, `دوشنبه ۱۲ دی ۱۳۸۴، ساعت ۲۲:۰۴:۰۵`, ref},
+		{`fa`, `%x|%EY|%EC|%B`, `2006/01/02|1384 ه‍.ش.|ه‍.ش.|ژانویه`, ref},
+		{`fa`, `%OY/%Om/%Od|%p`, `۲۰۰۶/۰۱/۰۲|ب.ظ.`, ref},
+		{`fa-IR`, `%EB %Ey`, `دی 1384`, ref},
+		{`fa-AF`, `%EB %Ey|%B`, `جدی 1384|جنوری`, ref},
+		{`en-u-ca-persian`, `%Ed %EB %EY|%Eb`, `12 Dey 1384 AP|Dey`, ref},
+		{`fa-u-ca-gregory`, `%Ex`, `2006/01/02`, ref},
+		// leap year 1403 has 30 days in Esfand
+		{`fa`, `%Ex`, `۱۴۰۳/۱۲/۳۰`, time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{`fa`, `%Ex`, `۱۴۰۴/۰۱/۰۱`, time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{`fa`, `%Ex`, `۱۴۰۴/۱۲/۲۹`, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{`fa`, `%Ex`, `۱۴۰۵/۰۱/۰۱`, time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{`fa`, `%Ex`, `۱۳۵۷/۱۱/۲۲`, time.Date(1979, 2, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		f := strftime.New(language.MustParse(tc.tag))
+		assert.Equal(t, tc.expected, f.Format(tc.format, tc.t), tc.tag+` `+tc.format)
+	}
+
+	res, err := strftime.New(language.Persian).Parse(`%OY/%Om/%Od`, `۲۰۰۶/۰۱/۰۲`)
+	if assert.NoError(t, err, `parsing Persian digits`) {
+		assert.Equal(t, ref.Truncate(24*time.Hour), res)
+	}
+	_, err = strftime.New(language.Persian).Parse(`%Ex`, `۱۳۸۴/۱۰/۱۲`)
+	assert.Error(t, err, `parsing Solar Hijri date`)
+}
+
+func TestHebrew(t *testing.T) {
+	ref := time.Unix(1136239445, 0).UTC() // 2 Tevet 5766
+
+	tests := []struct {
+		tag      string
+		format   string
+		expected string
+		t        time.Time
+	}{
+		{`he`, `%Ex`, `כ״ה בתשרי תשפ״ה`, time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC)},
+		{`he-u-ca-hebrew`, `%Ex`, `כ״ה בתשרי תשפ״ה`, time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%Ec`, `יום שני ב׳ בטבת תשס״ו 22:04:05`, ref},
+		{`he`, `%x|%EY|%Er|%Em|%Od`, `02.01.2006|5766 לבריאת העולם|5766|04|ב׳`, ref},
+		{`he-u-ca-gregory`, `%Ex`, `02.01.2006`, ref},
+		// Adar I and Adar II of leap years, and Adar of common years
+		{`he`, `%OEd %EB|%Em`, `י״ד אדר א׳|06`, time.Date(2024, 2, 23, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%OEd %EB|%Em`, `י״ד אדר ב׳|07`, time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%OEd %EB|%Em`, `י״ד אדר|06`, time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%OEd %EB`, `ט״ו ניסן`, time.Date(2025, 4, 13, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%OEd %EB`, `ט״ז ניסן`, time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC)},
+		{`he`, `%OEd %EB %OEy`, `א׳ תשרי תשפ״ו`, time.Date(2025, 9, 23, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-hebrew`, `%Ed %EB %EY|%EC`, `25 Kislev 5785 AM|AM`, time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-hebrew`, `%EB`, `Adar II`, time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)},
+		{`en-u-nu-hebr`, `%OY|%Od|%Ow`, `ב׳ו׳|ב׳|א׳`, ref},
+	}
+
+	for _, tc := range tests {
+		f := strftime.New(language.MustParse(tc.tag))
+		assert.Equal(t, tc.expected, f.Format(tc.format, tc.t), tc.tag+` `+tc.format)
+	}
+
+	_, err := strftime.New(language.Hebrew).Parse(`%Ex`, `ב׳ בטבת תשס״ו`)
+	assert.Error(t, err, `parsing Hebrew date`)
+}
+
+func TestChineseCalendar(t *testing.T) {
+	tests := []struct {
+		tag      string
+		format   string
+		expected string
+		t        time.Time
+	}{
+		{`zh-u-ca-chinese`, `%EY %EB %ED|%Ex|%EZ|%EC`, `甲辰年 腊月 初八|甲辰年腊月初八|龙|甲辰`, time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{`zh-Hant-u-ca-chinese`, `%Ex|%EZ`, `甲辰年臘月初八|龍`, time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{`zh-u-ca-chinese`, `%Ec`, `甲辰年腊月初八 12:30:00`, time.Date(2025, 1, 7, 12, 30, 0, 0, time.UTC)},
+		{`zh-u-ca-chinese`, `%OEd %OEm`, `八 十二`, time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+		// leap month of 2023, numbered as the month it follows
+		{`zh-u-ca-chinese`, `%EB %ED|%Em`, `闰二月 初一|02`, time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC)},
+		{`zh-u-ca-chinese`, `%EB %ED|%Em`, `三月 初一|03`, time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)},
+		// new year, one day later in Korea in 2027
+		{`zh-u-ca-chinese`, `%EY %EB %ED`, `丁未年 正月 初一`, time.Date(2027, 2, 6, 0, 0, 0, 0, time.UTC)},
+		{`ko-u-ca-dangi`, `%EY %EB %ED`, `병오년 12월 30일`, time.Date(2027, 2, 6, 0, 0, 0, 0, time.UTC)},
+		{`ko-u-ca-dangi`, `%Ex|%EZ`, `정미년 1월 1일|양`, time.Date(2027, 2, 7, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-chinese`, `%Ex|%EZ|%EC|%Ey|%Eb`, `Twelfth Month 8, 2024 (Jia-chen)|Dragon|Jia-chen|2024|Mo12`, time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+		// solar terms
+		{`zh-u-ca-chinese`, `[%ES][%Es]`, `[大寒][]`, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{`zh-u-ca-chinese`, `[%ES][%Es]`, `[立春][立春]`, time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+		{`zh-u-ca-chinese`, `[%ES][%Es]`, `[立春][]`, time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{`en-u-ca-chinese`, `%ES`, `Start of Spring`, time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+		// Gregorian fallback
+		{`en`, `[%ED][%EZ][%ES][%Es]`, `[4][][][]`, time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		f := strftime.New(language.MustParse(tc.tag))
+		assert.Equal(t, tc.expected, f.Format(tc.format, tc.t), tc.tag+` `+tc.format)
+	}
+
+	assert.NoError(t, strftime.Validate(`%ED %EZ %ES %Es`))
+	_, err := strftime.New(language.MustParse(`zh-u-ca-chinese`)).Parse(`%EY%EB%ED`, `甲辰年腊月初八`)
+	assert.Error(t, err, `parsing Chinese date`)
+}
+
+func TestGregorianCutover(t *testing.T) {
+	uk := strftime.New(language.BritishEnglish).WithGregorianCutover(strftime.GregorianCutoverBritain)
+	rome := strftime.New(language.Italian).WithGregorianCutover(strftime.GregorianCutoverRome)
+	ru := strftime.New(language.Russian).WithGregorianCutover(strftime.GregorianCutoverRussia)
+
+	tests := []struct {
+		f        *strftime.Formatter
+		format   string
+		expected string
+		t        time.Time
+	}{
+		// February 29th, 1700 only exists in the Julian calendar
+		{uk, `%A %d %B %Y|%j`, `Thursday 29 February 1700|060`, time.Date(1700, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{strftime.New(language.BritishEnglish), `%A %d %B %Y`, `Thursday 11 March 1700`, time.Date(1700, 3, 11, 0, 0, 0, 0, time.UTC)},
+		// the last Julian day and the first Gregorian day in Britain
+		{uk, `%x|%j`, `02/09/52|246`, time.Date(1752, 9, 13, 0, 0, 0, 0, time.UTC)},
+		{uk, `%x|%j`, `14/09/52|247`, time.Date(1752, 9, 14, 0, 0, 0, 0, time.UTC)},
+		{uk, `%x|%j`, `31/12/52|355`, time.Date(1752, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{rome, `%A %d %B %Y`, `giovedì 04 ottobre 1582`, time.Date(1582, 10, 14, 0, 0, 0, 0, time.UTC)},
+		{rome, `%A %d %B %Y`, `venerdì 15 ottobre 1582`, time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{ru, `%d %B %Y|%OB|%EY`, `25 октября 1917|Октябрь|1917`, time.Date(1917, 11, 7, 0, 0, 0, 0, time.UTC)},
+		{ru, `%d.%m.%Y`, `14.02.1918`, time.Date(1918, 2, 14, 0, 0, 0, 0, time.UTC)},
+		// Old Style and New Style years
+		{uk.WithDualYear(true), `%d %B %Y|%-Y`, `30 January 1720/21|1721`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{uk.WithDualYear(true), `%d %B %Y`, `19 February 1699/1700`, time.Date(1700, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{uk.WithDualYear(true), `%d %B %Y`, `30 March 1721`, time.Date(1721, 4, 10, 0, 0, 0, 0, time.UTC)},
+		{uk.WithDualYear(true), `%d %B %Y`, `01 January 1800`, time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{uk.WithDualYear(true), `%F|%5Y|%G`, `1721-01-30|01721|1721`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+		// Julian calendar for the E conversions
+		{strftime.New(language.English).WithCalendar(`julian`), `%Ed %EB %EY|%Ex|%x`, `25 October 1917|10/25/17|11/07/17`, time.Date(1917, 11, 7, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, tc.f.Format(tc.format, tc.t), tc.format)
+	}
+
+	// parsing
+	for _, tc := range []struct {
+		format, value string
+		expected      time.Time
+	}{
+		{`%d/%m/%Y`, `29/02/1700`, time.Date(1700, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{`%d/%m/%Y`, `02/09/1752`, time.Date(1752, 9, 13, 0, 0, 0, 0, time.UTC)},
+		{`%d/%m/%Y`, `14/09/1752`, time.Date(1752, 9, 14, 0, 0, 0, 0, time.UTC)},
+		{`%Y %j`, `1752 355`, time.Date(1752, 12, 31, 0, 0, 0, 0, time.UTC)},
+	} {
+		res, err := uk.Parse(tc.format, tc.value)
+		if assert.NoError(t, err, tc.value) {
+			assert.Equal(t, tc.expected, res, tc.value)
+		}
+	}
+	for _, value := range []string{`30/02/1700`, `10/09/1752`} {
+		_, err := uk.Parse(`%d/%m/%Y`, value)
+		assert.Error(t, err, value)
+	}
+
+	// dual years
+	dual := uk.WithDualYear(true)
+	for _, tc := range []struct {
+		format, value string
+		expected      time.Time
+	}{
+		{`%d %B %Y`, `30 January 1720/21`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{`%d %B %Y`, `19 February 1699/1700`, time.Date(1700, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{`%d %B %Y`, `30 January 1721`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{`%Y/%m/%d`, `1721/01/30`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{`%F`, `1721-01-30`, time.Date(1721, 2, 10, 0, 0, 0, 0, time.UTC)},
+	} {
+		res, err := dual.Parse(tc.format, tc.value)
+		if assert.NoError(t, err, tc.value) {
+			assert.Equal(t, tc.expected, res, tc.value)
+		}
+	}
+	for _, value := range []string{`30 January 1720/22`, `19 February 1699/00`} {
+		_, err := dual.Parse(`%d %B %Y`, value)
+		assert.Error(t, err, value)
+	}
+}
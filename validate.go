@@ -0,0 +1,197 @@
+// Package strftime implements C-like strftime functionality with locale support.
+package strftime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FormatError describes an invalid conversion specification found in a format string.
+type FormatError struct {
+	Pattern string // Format string containing the error
+	Offset  int    // Byte offset of the conversion specification in Pattern
+	Spec    string // Offending conversion specification, including the % sign
+	Message string // Description of the problem
+}
+
+// Error returns the string representation of a FormatError.
+func (e *FormatError) Error() string {
+	return "strftime: " + e.Message + " " + strconv.Quote(e.Spec) + " at offset " + strconv.Itoa(e.Offset) + " in " + strconv.Quote(e.Pattern)
+}
+
+// Validate checks that every conversion specification in format string f is
+// supported. Format outputs unsupported specifications as is, which is convenient
+// for fixed formats but can hide mistakes in user-provided ones.
+//
+// Era conversions (%Ec) are checked against the Gregorian calendar. Formatter.Compile
+// and Formatter.FormatStrict check them against the calendar of their locale.
+//
+// Parameters:
+//   - f: Format string with strftime-compatible format specifiers
+//
+// Returns: nil if f is valid, or a *FormatError describing the first invalid
+// conversion specification
+func Validate(f string) error {
+	return EnglishFormatter.validate(f)
+}
+
+// convSpec is the syntax of a conversion specification decoded by scanSpec, such as
+// %-3d, %OEy or %::z. Offsets are relative to the % sign.
+type convSpec struct {
+	pad      byte // padding flag ('-', '_' or '0'), 0 if none
+	caseFlag byte // case flag ('^' or '#'), 0 if none
+	width    int  // field width, 0 if none
+	conv     int  // offset of the conversion, after the flags and field width
+	letter   int  // offset of the conversion character, after the modifiers and colons
+	end      int  // offset of the end of the specification
+}
+
+// scanSpec decodes the conversion specification at the start of f, which starts with a
+// % sign: its flags, field width, E and O modifiers, colons and conversion character.
+// It does not check that the conversion exists (see knownConversion). ok is false if f
+// ends before the conversion character.
+func scanSpec(f string) (s convSpec, ok bool) {
+	i := 1
+	for ; i < len(f) && isFlag(f[i]); i++ {
+		switch f[i] {
+		case '^', '#':
+			s.caseFlag = f[i]
+		default:
+			s.pad = f[i]
+		}
+	}
+	for ; i < len(f) && f[i] >= '0' && f[i] <= '9'; i++ {
+		s.width = s.width*10 + int(f[i]-'0')
+	}
+	s.conv = i
+	if i < len(f) && f[i] == 'O' {
+		i++
+	}
+	if i < len(f) && f[i] == 'E' {
+		i++
+	}
+	for n := 0; n < 3 && i < len(f) && f[i] == ':'; n++ {
+		i++
+	}
+	if i >= len(f) {
+		return s, false
+	}
+	s.letter, s.end = i, i+1
+	return s, true
+}
+
+// knownConversion reports whether conversion specification s at the start of f is
+// supported by Format in every locale, or, if era is true, whether it is an era
+// conversion (%Ec or %OEc) that depends on the calendar of the locale.
+func knownConversion(f string, s convSpec) (known, era bool) {
+	mod, c := strings.TrimRight(f[s.conv:s.letter], ":"), f[s.letter]
+	colons := s.letter - s.conv - len(mod)
+	switch mod {
+	case "":
+		if colons > 0 {
+			return c == 'z' || c == 'Q' && colons < 3, false
+		}
+		return strings.IndexByte("aAbBcCdDeFfgGhHIjklLmMnNpPQrRsStTuUvVwWxXyYzZ%", c) != -1, false
+	case "O":
+		return colons == 0 && strings.IndexByte("bBCdegGhHIjklmMQsSuUVwWyY", c) != -1, false
+	case "E", "OE":
+		if colons > 0 || c == 'z' {
+			return c == 'z', false
+		}
+		if strings.IndexByte("bBcdeghmxX", c) != -1 {
+			return true, false
+		}
+		return false, c < utf8.RuneSelf
+	}
+	return false, false
+}
+
+// knownSpec reports whether fm formats conversion specification s at the start of f,
+// checking era conversions against the calendar of its locale.
+func (fm *Formatter) knownSpec(f string, s convSpec) bool {
+	known, era := knownConversion(f, s)
+	return known || era && fm.hasEra(f[s.letter])
+}
+
+// hasEra reports whether the calendar of the locale of fm supports era conversion %Ec.
+func (fm *Formatter) hasEra(c byte) bool {
+	t := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if cal := localeCalendar(fm.l); cal != nil {
+		_, ok := cal.AppendEra(nil, fm.l.Tag, cal.Date(t), c)
+		return ok
+	}
+	_, ok := appendGregorianEra(nil, gregorianDate(t), c)
+	return ok
+}
+
+// validate returns a *FormatError for the first unknown or truncated conversion
+// specification in f, or nil if there is none.
+func (fm *Formatter) validate(f string) error {
+	for pos := 0; pos < len(f); {
+		i := strings.IndexByte(f[pos:], '%')
+		if i == -1 {
+			return nil
+		}
+		pos += i
+
+		s, ok := scanSpec(f[pos:])
+		if !ok {
+			return formatError(f, pos, len(f)-pos, "truncated conversion specification")
+		}
+		if !fm.knownSpec(f[pos:], s) {
+			return formatError(f, pos, s.letter, "unknown conversion specification")
+		}
+		pos += s.end
+	}
+	return nil
+}
+
+// scanFormat calls fn for each conversion specification of f recognized by Format,
+// with the offsets of its % sign, of its conversion character (after any flags, field
+// width and modifiers) and of its end, until fn returns false. Unknown specifications
+// are skipped, as Format copies them to the output. Era conversions are all taken as
+// known, whatever the calendar.
+func scanFormat(f string, fn func(pos, conv, end int) bool) {
+	for pos := 0; pos < len(f); {
+		i := strings.IndexByte(f[pos:], '%')
+		if i == -1 {
+			return
+		}
+		pos += i
+
+		s, ok := scanSpec(f[pos:])
+		if !ok {
+			return
+		}
+		if known, era := knownConversion(f[pos:], s); !known && !era {
+			pos++
+			continue
+		}
+		conv := pos + s.conv
+		for conv < pos+s.letter && (f[conv] == 'E' || f[conv] == 'O') {
+			conv++
+		}
+		if !fn(pos, conv, pos+s.end) {
+			return
+		}
+		pos += s.end
+	}
+}
+
+// formatError builds a FormatError for the conversion specification at offset pos of f,
+// made of n bytes plus the character that follows them, if any.
+func formatError(f string, pos, n int, msg string) error {
+	end := pos + n
+	if end < len(f) {
+		_, size := utf8.DecodeRuneInString(f[end:])
+		end += size
+	}
+	return &FormatError{
+		Pattern: f,
+		Offset:  pos,
+		Spec:    f[pos:end],
+		Message: msg,
+	}
+}